@@ -1,100 +1,238 @@
 package cdp
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"sync"
+	"sync/atomic"
 )
 
+// BrokerChannelSize is the buffer size of subscription without its own one
 var BrokerChannelSize = 50000
 
-type subscriber struct {
-	sessionID string
-	channel   chan Message
+var ErrSubscriptionOverflow = errors.New("subscription overflow")
+
+// OverflowPolicy decides what happens with the message when the subscription buffer is full
+type OverflowPolicy int
+
+const (
+	// OverflowDropOldest discards the oldest buffered message in favour of the new one, it's the default
+	OverflowDropOldest OverflowPolicy = iota
+	// OverflowError discards the new message and reports ErrSubscriptionOverflow by Err
+	OverflowError
+	// OverflowBlock waits for the subscriber, it delays delivery to all subscribers of the transport.
+	// It's only for subscribers which never wait for anything else while reading
+	OverflowBlock
+)
+
+type SubscribeOptions struct {
+	// SessionID receives messages of the session and the browser ones, all messages if it's empty
+	SessionID string
+	// Methods are exact method names or domain wildcards like "Network.*", all methods if it's empty
+	Methods  []string
+	Size     int
+	Overflow OverflowPolicy
 }
 
-type broker struct {
-	cancel   chan struct{}
-	messages chan Message
-	sub      chan subscriber
-	unsub    chan chan Message
-	lock     *sync.Mutex
+// Subscription is a channel of messages routed by the broker
+type Subscription struct {
+	options  SubscribeOptions
+	methods  map[string]struct{}
+	domains  []string
+	channel  chan Message
+	done     chan struct{}
+	once     sync.Once
+	mutex    sync.Mutex
+	closed   bool
+	dropped  atomic.Uint64
+	overflow atomic.Bool
+	broker   *broker
 }
 
-func makeBroker() broker {
-	return broker{
-		cancel:   make(chan struct{}),
-		messages: make(chan Message),
-		sub:      make(chan subscriber),
-		unsub:    make(chan chan Message),
-		lock:     &sync.Mutex{},
+// C returns the channel of messages, it's closed by Cancel or by the transport close
+func (s *Subscription) C() chan Message {
+	return s.channel
+}
+
+// Dropped returns the number of messages discarded because of the overflow
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Err returns ErrSubscriptionOverflow if messages were discarded with OverflowError policy
+func (s *Subscription) Err() error {
+	if s.overflow.Load() {
+		return fmt.Errorf("%w: %d messages dropped", ErrSubscriptionOverflow, s.Dropped())
 	}
+	return nil
 }
 
-func (b broker) run() {
-	var value = map[chan Message]subscriber{}
-	for {
-		select {
+func (s *Subscription) Cancel() {
+	if s.broker != nil {
+		s.broker.unsubscribe(s)
+	}
+	s.close()
+}
 
-		case sub := <-b.sub:
-			value[sub.channel] = sub
+func (s *Subscription) close() {
+	s.once.Do(func() {
+		close(s.done)
+		// waits for the message being delivered
+		s.mutex.Lock()
+		s.closed = true
+		close(s.channel)
+		s.mutex.Unlock()
+	})
+}
 
-		case channel := <-b.unsub:
-			if _, ok := value[channel]; ok {
-				delete(value, channel)
-				close(channel)
-			}
+func (s *Subscription) match(method string) bool {
+	if len(s.options.Methods) == 0 {
+		return true
+	}
+	if _, ok := s.methods[method]; ok {
+		return true
+	}
+	for _, domain := range s.domains {
+		if strings.HasPrefix(method, domain) {
+			return true
+		}
+	}
+	return false
+}
 
-		case <-b.cancel:
-			for msgCh := range value {
-				close(msgCh)
+func (s *Subscription) deliver(message Message) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.closed {
+		return
+	}
+	switch s.options.Overflow {
+	case OverflowBlock:
+		select {
+		case s.channel <- message:
+		case <-s.done:
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case s.channel <- message:
+				return
+			default:
 			}
-			close(b.sub)
-			close(b.unsub)
-			close(b.messages)
-			return
-
-		case message := <-b.messages:
-			for _, subscriber := range value {
-				if message.SessionID == "" || subscriber.sessionID == "" || message.SessionID == subscriber.sessionID {
-					subscriber.channel <- message
-				}
+			select {
+			case <-s.channel:
+				s.dropped.Add(1)
+			default:
 			}
 		}
+	case OverflowError:
+		select {
+		case s.channel <- message:
+		default:
+			s.dropped.Add(1)
+			s.overflow.Store(true)
+		}
 	}
 }
 
-func (b broker) subscribe(sessionID string) chan Message {
-	b.lock.Lock()
-	defer b.lock.Unlock()
+// broker routes messages to subscriptions indexed by their session
+type broker struct {
+	mutex     sync.RWMutex
+	cancelled bool
+	sessions  map[string]map[*Subscription]struct{}
+}
+
+func newBroker() *broker {
+	return &broker{sessions: map[string]map[*Subscription]struct{}{}}
+}
 
-	select {
-	case <-b.cancel:
-		return nil
-	default:
-		sub := subscriber{
-			sessionID: sessionID,
-			channel:   make(chan Message, BrokerChannelSize),
+func newSubscription(options SubscribeOptions) *Subscription {
+	size := options.Size
+	if size <= 0 {
+		size = BrokerChannelSize
+	}
+	s := &Subscription{
+		options: options,
+		methods: map[string]struct{}{},
+		channel: make(chan Message, size),
+		done:    make(chan struct{}),
+	}
+	for _, method := range options.Methods {
+		if domain, ok := strings.CutSuffix(method, "*"); ok {
+			s.domains = append(s.domains, domain)
+		} else {
+			s.methods[method] = struct{}{}
 		}
-		b.sub <- sub
-		return sub.channel
 	}
+	return s
 }
 
-func (b broker) unsubscribe(value chan Message) {
-	b.lock.Lock()
-	select {
-	case <-b.cancel:
-	default:
-		b.unsub <- value
+// subscribe returns closed subscription if the broker is cancelled
+func (b *broker) subscribe(options SubscribeOptions) *Subscription {
+	s := newSubscription(options)
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.cancelled {
+		s.close()
+		return s
+	}
+	s.broker = b
+	index, ok := b.sessions[options.SessionID]
+	if !ok {
+		index = map[*Subscription]struct{}{}
+		b.sessions[options.SessionID] = index
 	}
-	b.lock.Unlock()
+	index[s] = struct{}{}
+	return s
 }
 
-func (b broker) publish(msg Message) {
-	b.messages <- msg
+func (b *broker) unsubscribe(s *Subscription) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if index, ok := b.sessions[s.options.SessionID]; ok {
+		delete(index, s)
+		if len(index) == 0 {
+			delete(b.sessions, s.options.SessionID)
+		}
+	}
 }
 
-func (b broker) Cancel() {
-	b.lock.Lock()
-	close(b.cancel)
-	b.lock.Unlock()
+// publish delivers message of the session to its subscriptions and to the ones of all sessions,
+// browser message without session goes to everyone
+func (b *broker) publish(message Message) {
+	var targets []*Subscription
+	b.mutex.RLock()
+	collect := func(index map[*Subscription]struct{}) {
+		for s := range index {
+			if s.match(message.Method) {
+				targets = append(targets, s)
+			}
+		}
+	}
+	if message.SessionID == "" {
+		for _, index := range b.sessions {
+			collect(index)
+		}
+	} else {
+		collect(b.sessions[message.SessionID])
+		collect(b.sessions[""])
+	}
+	b.mutex.RUnlock()
+	for _, s := range targets {
+		s.deliver(message)
+	}
+}
+
+func (b *broker) Cancel() {
+	b.mutex.Lock()
+	b.cancelled = true
+	sessions := b.sessions
+	b.sessions = map[string]map[*Subscription]struct{}{}
+	b.mutex.Unlock()
+	for _, index := range sessions {
+		for s := range index {
+			s.close()
+		}
+	}
 }
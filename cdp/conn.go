@@ -0,0 +1,48 @@
+package cdp
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// Conn is a duplex channel of CDP messages, *websocket.Conn implements it
+type Conn interface {
+	WriteJSON(v any) error
+	ReadJSON(v any) error
+	Close() error
+}
+
+type pipeConn struct {
+	reader *bufio.Reader
+	pipe   io.ReadWriteCloser
+}
+
+// NewPipeConn makes Conn of --remote-debugging-pipe, messages are NUL-delimited JSON
+func NewPipeConn(pipe io.ReadWriteCloser) Conn {
+	return &pipeConn{
+		reader: bufio.NewReaderSize(pipe, 8192),
+		pipe:   pipe,
+	}
+}
+
+func (p *pipeConn) WriteJSON(v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = p.pipe.Write(append(b, 0))
+	return err
+}
+
+func (p *pipeConn) ReadJSON(v any) error {
+	b, err := p.reader.ReadBytes(0)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b[:len(b)-1], v)
+}
+
+func (p *pipeConn) Close() error {
+	return p.pipe.Close()
+}
@@ -0,0 +1,70 @@
+package cdp_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/retrozoid/control/cdp"
+)
+
+type pipe struct {
+	io.Reader
+	io.Writer
+	io.Closer
+}
+
+func TestPipeConnFraming(t *testing.T) {
+	large := `{"id":6,"params":{"data":"` + strings.Repeat("x", 20000) + `"}}`
+	// every chunk is read at once, reads never join two chunks
+	chunks := []string{
+		`{"id":1}` + "\x00" + `{"id":2}` + "\x00" + `{"id":3}` + "\x00",
+		`{"id":4,"method":"Te`,
+		`st.split"}` + "\x00" + `{"id":`,
+		`5}` + "\x00",
+		large[:5000],
+		large[5000:] + "\x00",
+	}
+	reader, writer := io.Pipe()
+	var sent bytes.Buffer
+	conn := cdp.NewPipeConn(pipe{Reader: reader, Writer: &sent, Closer: reader})
+	go func() {
+		for _, chunk := range chunks {
+			if _, err := writer.Write([]byte(chunk)); err != nil {
+				return
+			}
+		}
+		writer.Close()
+	}()
+
+	for _, want := range []string{`{"id":1}`, `{"id":2}`, `{"id":3}`, `{"id":4,"method":"Test.split"}`, `{"id":5}`, large} {
+		var message map[string]any
+		if err := conn.ReadJSON(&message); err != nil {
+			t.Fatal(err)
+		}
+		var expected map[string]any
+		if err := json.Unmarshal([]byte(want), &expected); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(message, expected) {
+			t.Errorf("read %.80v, want %.80s", message, want)
+		}
+	}
+	if err := conn.ReadJSON(&struct{}{}); !errors.Is(err, io.EOF) {
+		t.Errorf("end of the pipe: %v", err)
+	}
+
+	if err := conn.WriteJSON(cdp.Request{ID: 1, Method: "Test.write"}); err != nil {
+		t.Fatal(err)
+	}
+	if sent.String() != `{"id":1,"method":"Test.write"}`+"\x00" {
+		t.Errorf("written %q", sent.String())
+	}
+	if err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,270 @@
+package cdp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"sync"
+	"time"
+)
+
+type Direction string
+
+const (
+	DirectionSend Direction = "send"
+	DirectionRecv Direction = "recv"
+)
+
+// TraceEntry is a line of JSON-lines trace file
+type TraceEntry struct {
+	Time      time.Time       `json:"time"`
+	Direction Direction       `json:"direction"`
+	Message   json.RawMessage `json:"message"`
+}
+
+type recordingConn struct {
+	conn  Conn
+	mutex sync.Mutex
+	w     io.Writer
+	err   error
+}
+
+// NewRecordingConn writes every message sent and received by the connection to w as JSON lines.
+// Tracing errors don't break the connection, they are reported by the Close
+func NewRecordingConn(conn Conn, w io.Writer) Conn {
+	return &recordingConn{conn: conn, w: w}
+}
+
+func (r *recordingConn) record(direction Direction, message json.RawMessage) {
+	b, err := json.Marshal(TraceEntry{Time: time.Now(), Direction: direction, Message: message})
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.err != nil {
+		return
+	}
+	if err == nil {
+		_, err = r.w.Write(append(b, '\n'))
+	}
+	r.err = err
+}
+
+func (r *recordingConn) WriteJSON(v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	// the request is recorded before the response can be read, replay relies on this order
+	r.record(DirectionSend, b)
+	return r.conn.WriteJSON(json.RawMessage(b))
+}
+
+func (r *recordingConn) ReadJSON(v any) error {
+	var b json.RawMessage
+	if err := r.conn.ReadJSON(&b); err != nil {
+		return err
+	}
+	r.record(DirectionRecv, b)
+	return json.Unmarshal(b, v)
+}
+
+func (r *recordingConn) Close() error {
+	err := r.conn.Close()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return errors.Join(err, r.err)
+}
+
+// ReadTrace reads JSON-lines trace written by NewRecordingConn
+func ReadTrace(r io.Reader) ([]TraceEntry, error) {
+	var (
+		entries []TraceEntry
+		scanner = bufio.NewScanner(r)
+	)
+	scanner.Buffer(make([]byte, 64*1024), 256*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry TraceEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("trace line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+type replayEntry struct {
+	direction Direction
+	id        uint64
+	sessionID string
+	method    string
+	params    any
+	raw       json.RawMessage
+	matched   bool
+}
+
+type replayConn struct {
+	mutex   sync.Mutex
+	cond    *sync.Cond
+	entries []*replayEntry
+	cursor  int
+	// ids maps the recorded request id to the id sent by the client
+	ids     map[uint64]uint64
+	pending []json.RawMessage
+	closed  bool
+}
+
+// NewReplayConn serves a recorded trace instead of the browser.
+// Sent request is matched to the first unused recorded one of the same method, session and params,
+// then the received messages are emitted in recorded order as soon as all requests recorded before them are sent.
+// Request that wasn't recorded is answered with error
+func NewReplayConn(r io.Reader) (Conn, error) {
+	trace, err := ReadTrace(r)
+	if err != nil {
+		return nil, err
+	}
+	conn := &replayConn{ids: map[uint64]uint64{}}
+	conn.cond = sync.NewCond(&conn.mutex)
+	for n, entry := range trace {
+		var value struct {
+			ID        uint64          `json:"id"`
+			SessionID string          `json:"sessionId"`
+			Method    string          `json:"method"`
+			Params    json.RawMessage `json:"params"`
+		}
+		if err = json.Unmarshal(entry.Message, &value); err != nil {
+			return nil, fmt.Errorf("trace entry %d: %w", n+1, err)
+		}
+		params, err := normalizeParams(value.Params)
+		if err != nil {
+			return nil, fmt.Errorf("trace entry %d: %w", n+1, err)
+		}
+		conn.entries = append(conn.entries, &replayEntry{
+			direction: entry.Direction,
+			id:        value.ID,
+			sessionID: value.SessionID,
+			method:    value.Method,
+			params:    params,
+			raw:       entry.Message,
+		})
+	}
+	return conn, nil
+}
+
+func normalizeParams(b json.RawMessage) (value any, err error) {
+	if len(b) == 0 || string(b) == "null" {
+		return nil, nil
+	}
+	if err = json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+	// empty params are omitted by some senders
+	if m, ok := value.(map[string]any); ok && len(m) == 0 {
+		return nil, nil
+	}
+	return value, nil
+}
+
+func (r *replayConn) WriteJSON(v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var request struct {
+		ID        uint64          `json:"id"`
+		SessionID string          `json:"sessionId"`
+		Method    string          `json:"method"`
+		Params    json.RawMessage `json:"params"`
+	}
+	if err = json.Unmarshal(b, &request); err != nil {
+		return err
+	}
+	params, err := normalizeParams(request.Params)
+	if err != nil {
+		return err
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.closed {
+		return net.ErrClosed
+	}
+	for _, entry := range r.entries {
+		if entry.direction == DirectionSend && !entry.matched &&
+			entry.method == request.Method &&
+			entry.sessionID == request.SessionID &&
+			reflect.DeepEqual(entry.params, params) {
+			entry.matched = true
+			r.ids[entry.id] = request.ID
+			r.cond.Broadcast()
+			return nil
+		}
+	}
+	reply, err := json.Marshal(Response{
+		ID:    request.ID,
+		Error: &Error{Code: -32000, Message: "replay: request was not recorded " + string(b)},
+	})
+	if err != nil {
+		return err
+	}
+	r.pending = append(r.pending, reply)
+	r.cond.Broadcast()
+	return nil
+}
+
+// next returns the message to emit, must be called under the lock
+func (r *replayConn) next() (json.RawMessage, bool) {
+	if len(r.pending) > 0 {
+		b := r.pending[0]
+		r.pending = r.pending[1:]
+		return b, true
+	}
+	for ; r.cursor < len(r.entries); r.cursor++ {
+		entry := r.entries[r.cursor]
+		if entry.direction == DirectionSend {
+			if !entry.matched {
+				return nil, false
+			}
+			continue
+		}
+		r.cursor++
+		if entry.id == 0 {
+			return entry.raw, true
+		}
+		var response map[string]json.RawMessage
+		if err := json.Unmarshal(entry.raw, &response); err != nil {
+			return entry.raw, true
+		}
+		response["id"], _ = json.Marshal(r.ids[entry.id])
+		b, _ := json.Marshal(response)
+		return b, true
+	}
+	return nil, false
+}
+
+// ReadJSON blocks until the next recorded message is due, the end of the trace blocks until Close
+func (r *replayConn) ReadJSON(v any) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for {
+		if r.closed {
+			return net.ErrClosed
+		}
+		if b, ok := r.next(); ok {
+			return json.Unmarshal(b, v)
+		}
+		r.cond.Wait()
+	}
+}
+
+func (r *replayConn) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.closed = true
+	r.cond.Broadcast()
+	return nil
+}
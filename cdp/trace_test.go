@@ -0,0 +1,119 @@
+package cdp_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/retrozoid/control/cdp"
+	"github.com/retrozoid/control/cdptest"
+)
+
+// recordTrace records the calls made one by one to cdptest server, Test.last emits Test.event before its response
+func recordTrace(t *testing.T, requests []*cdp.Request) *bytes.Buffer {
+	t.Helper()
+	server := cdptest.NewServer()
+	defer server.Close()
+	server.Handle("Test.echo", func(c cdptest.Call) (any, error) {
+		return c.Params, nil
+	})
+	server.Handle("Test.last", func(c cdptest.Call) (any, error) {
+		return map[string]string{"result": "last"}, server.Emit("", "Test.event", map[string]string{"value": "event"})
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ws, _, err := cdp.DefaultDialer.Dial(server.URL(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var trace bytes.Buffer
+	transport := cdp.NewTransport(ctx, cdp.NewRecordingConn(ws, &trace), nil)
+	for _, request := range requests {
+		if _, err = transport.Send(request).Get(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = transport.Disconnect(); err != nil {
+		t.Fatal(err)
+	}
+	return &trace
+}
+
+func TestReplayTrace(t *testing.T) {
+	trace := recordTrace(t, []*cdp.Request{
+		{Method: "Test.echo", Params: json.RawMessage(`{"b":1,"a":[1,2]}`)},
+		{Method: "Test.echo", Params: map[string]any{}},
+		{Method: "Test.last"},
+	})
+	entries, err := cdp.ReadTrace(bytes.NewReader(trace.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 3 requests, 3 responses and the event
+	if len(entries) != 7 || entries[0].Direction != cdp.DirectionSend {
+		t.Fatalf("unexpected trace %v", entries)
+	}
+
+	conn, err := cdp.NewReplayConn(trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	transport := cdp.NewTransport(ctx, conn, nil)
+	defer transport.Disconnect()
+	events := transport.SubscribeWithOptions(cdp.SubscribeOptions{Methods: []string{"Test.event"}, Size: 1})
+	defer events.Cancel()
+
+	// the mismatch takes id 1, so the replayed responses are remapped to other ids
+	_, err = transport.Send(&cdp.Request{Method: "Test.echo", Params: map[string]any{"a": 2}}).Get(ctx)
+	var cdpError *cdp.Error
+	if !errors.As(err, &cdpError) || !strings.Contains(cdpError.Message, "not recorded") {
+		t.Fatalf("request which isn't recorded: %v", err)
+	}
+
+	// the last request is sent first, its response is due after the earlier recorded requests are sent
+	last := transport.Send(&cdp.Request{Method: "Test.last"})
+	select {
+	case <-events.C():
+		t.Fatal("event is emitted before the requests recorded earlier")
+	case <-time.After(20 * time.Millisecond):
+	}
+	// params are matched whatever the key order is, empty params are the same as omitted ones
+	first := transport.Send(&cdp.Request{Method: "Test.echo", Params: map[string]any{"a": []int{1, 2}, "b": 1}})
+	empty := transport.Send(&cdp.Request{Method: "Test.echo"})
+	for _, test := range []struct {
+		future cdp.Future[cdp.Response]
+		result string
+	}{
+		{first, `{"b":1,"a":[1,2]}`},
+		{empty, `{}`},
+		{last, `{"result":"last"}`},
+	} {
+		response, err := test.future.Get(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(response.Result) != test.result {
+			t.Errorf("replayed result %s, want %s", response.Result, test.result)
+		}
+	}
+	select {
+	case message := <-events.C():
+		if string(message.Params) != `{"value":"event"}` {
+			t.Errorf("unexpected event %s", message.Params)
+		}
+	case <-ctx.Done():
+		t.Fatal("recorded event isn't replayed")
+	}
+
+	// every recorded request is replayed once
+	_, err = transport.Send(&cdp.Request{Method: "Test.last"}).Get(ctx)
+	if !errors.As(err, &cdpError) {
+		t.Errorf("request replayed twice: %v", err)
+	}
+}
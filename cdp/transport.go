@@ -23,11 +23,11 @@ var ErrGracefullyClosed = errors.New("gracefully closed")
 type Transport struct {
 	context context.Context
 	cancel  func(error)
-	conn    *websocket.Conn
+	conn    Conn
 	seq     uint64
 	pending map[uint64]*promise[Response]
 	mutex   sync.Mutex
-	broker  broker
+	broker  *broker
 	logger  *slog.Logger
 }
 
@@ -40,17 +40,21 @@ func Dial(parent context.Context, dialer websocket.Dialer, url string, logger *s
 	if err != nil {
 		return nil, err
 	}
+	return NewTransport(parent, conn, logger), nil
+}
+
+// NewTransport makes Transport over the connection and starts reading its messages
+func NewTransport(parent context.Context, conn Conn, logger *slog.Logger) *Transport {
 	ctx, cancel := context.WithCancelCause(parent)
 	transport := &Transport{
 		context: ctx,
 		cancel:  cancel,
 		conn:    conn,
 		seq:     1,
-		broker:  makeBroker(),
+		broker:  newBroker(),
 		pending: make(map[uint64]*promise[Response]),
 		logger:  logger,
 	}
-	go transport.broker.run()
 	go func() {
 		var readerr error
 		for ; readerr == nil; readerr = transport.read() {
@@ -58,7 +62,7 @@ func Dial(parent context.Context, dialer websocket.Dialer, url string, logger *s
 		transport.cancel(readerr)
 		transport.gracefullyClose()
 	}()
-	return transport, nil
+	return transport
 }
 
 func (t *Transport) Log(level slog.Level, msg string, args ...any) {
@@ -85,6 +89,17 @@ func (t *Transport) Close() error {
 	}
 }
 
+// Disconnect closes the connection leaving the browser running
+func (t *Transport) Disconnect() error {
+	select {
+	case <-t.context.Done():
+		return context.Cause(t.context)
+	default:
+		t.cancel(ErrGracefullyClosed)
+		return t.conn.Close()
+	}
+}
+
 func (t *Transport) gracefullyClose() {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
@@ -96,13 +111,15 @@ func (t *Transport) gracefullyClose() {
 	}
 }
 
+// Subscribe returns the channel of every message of the session, the transport waits for the channel to be read when its buffer is full
 func (t *Transport) Subscribe(sessionID string) (chan Message, func()) {
-	channel := t.broker.subscribe(sessionID)
-	return channel, func() {
-		if channel != nil {
-			t.broker.unsubscribe(channel)
-		}
-	}
+	subscription := t.SubscribeWithOptions(SubscribeOptions{SessionID: sessionID, Overflow: OverflowBlock})
+	return subscription.C(), subscription.Cancel
+}
+
+// SubscribeWithOptions subscribes to the messages filtered by the broker, the subscription is closed with the transport
+func (t *Transport) SubscribeWithOptions(options SubscribeOptions) *Subscription {
+	return t.broker.subscribe(options)
 }
 
 func (t *Transport) Send(request *Request) Future[Response] {
@@ -0,0 +1,280 @@
+package cdp_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/retrozoid/control/cdp"
+	"github.com/retrozoid/control/cdptest"
+)
+
+func dial(t *testing.T) (*cdptest.Server, *cdp.Transport, context.Context) {
+	t.Helper()
+	server := cdptest.NewServer()
+	t.Cleanup(server.Close)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	transport, err := server.Dial(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = transport.Disconnect() })
+	return server, transport, ctx
+}
+
+func TestTransportErrorResponse(t *testing.T) {
+	server, transport, ctx := dial(t)
+	server.Handle("Test.fail", cdptest.Fail(-32000, "boom"))
+	server.Handle("Test.ok", cdptest.Result(map[string]int{"value": 1}))
+
+	_, err := transport.Send(&cdp.Request{Method: "Test.fail"}).Get(ctx)
+	var cdpError *cdp.Error
+	if !errors.As(err, &cdpError) {
+		t.Fatalf("error %v is not *cdp.Error", err)
+	}
+	if cdpError.Code != -32000 || cdpError.Message != "boom" {
+		t.Errorf("unexpected error %+v", cdpError)
+	}
+
+	// error response rejects the call only, the transport keeps working
+	response, err := transport.Send(&cdp.Request{Method: "Test.ok"}).Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(response.Result) != `{"value":1}` {
+		t.Errorf("unexpected result %s", response.Result)
+	}
+	if err = context.Cause(transport.Context()); err != nil {
+		t.Errorf("transport is closed: %v", err)
+	}
+}
+
+func TestTransportEventsInterleavedWithResponses(t *testing.T) {
+	server, transport, ctx := dial(t)
+	const calls = 50
+	server.Handle("Test.echo", func(c cdptest.Call) (any, error) {
+		var args struct {
+			N int `json:"n"`
+		}
+		if err := c.Unmarshal(&args); err != nil {
+			return nil, err
+		}
+		// the event is written before the response of the same call
+		if err := server.Emit(c.SessionID, "Test.echoed", args); err != nil {
+			return nil, err
+		}
+		return args, nil
+	})
+	subscription := transport.SubscribeWithOptions(cdp.SubscribeOptions{Methods: []string{"Test.echoed"}, Size: calls})
+	defer subscription.Cancel()
+
+	var wg sync.WaitGroup
+	for n := 0; n < calls; n++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			response, err := transport.Send(&cdp.Request{Method: "Test.echo", Params: map[string]int{"n": n}}).Get(ctx)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if want := `{"n":` + strconv.Itoa(n) + `}`; string(response.Result) != want {
+				t.Errorf("call %d resolved with %s", n, response.Result)
+			}
+		}(n)
+	}
+	wg.Wait()
+
+	// events are published before the following responses are read, all of them are buffered already
+	seen := map[int]bool{}
+	for len(seen) < calls {
+		select {
+		case message := <-subscription.C():
+			var params struct {
+				N int `json:"n"`
+			}
+			if err := json.Unmarshal(message.Params, &params); err != nil {
+				t.Fatal(err)
+			}
+			seen[params.N] = true
+		default:
+			t.Fatalf("%d of %d events received before the responses", len(seen), calls)
+		}
+	}
+	if subscription.Dropped() != 0 {
+		t.Errorf("%d events dropped", subscription.Dropped())
+	}
+}
+
+func TestTransportUnknownResponseID(t *testing.T) {
+	server, transport, ctx := dial(t)
+	release := make(chan struct{})
+	defer close(release)
+	server.Handle("Test.block", func(cdptest.Call) (any, error) {
+		<-release
+		return nil, nil
+	})
+	pending := transport.Send(&cdp.Request{Method: "Test.block"})
+	if _, err := server.WaitCall(ctx, "Test.block"); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.Send(map[string]any{"id": 1000, "result": map[string]any{}}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := pending.Get(ctx)
+	if err == nil || !strings.Contains(err.Error(), "unexpected response") {
+		t.Fatalf("pending call is not rejected by the protocol error: %v", err)
+	}
+	select {
+	case <-transport.Context().Done():
+	case <-ctx.Done():
+		t.Fatal("transport is not closed")
+	}
+	if _, err = transport.Send(&cdp.Request{Method: "Test.ok"}).Get(ctx); err == nil {
+		t.Error("closed transport sends the call")
+	}
+}
+
+func TestTransportDisconnectWithCallsInFlight(t *testing.T) {
+	server, transport, ctx := dial(t)
+	var (
+		futures = make([]cdp.Future[cdp.Response], 3)
+		started = make(chan struct{}, len(futures))
+		release = make(chan struct{})
+	)
+	defer close(release)
+	server.Handle("Test.block", func(cdptest.Call) (any, error) {
+		started <- struct{}{}
+		<-release
+		return nil, nil
+	})
+	subscription := transport.SubscribeWithOptions(cdp.SubscribeOptions{Size: 1})
+
+	for i := range futures {
+		futures[i] = transport.Send(&cdp.Request{Method: "Test.block"})
+	}
+	for range futures {
+		<-started
+	}
+	server.Disconnect()
+
+	for i, future := range futures {
+		if _, err := future.Get(ctx); err == nil || errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("call %d is not rejected by the disconnect: %v", i, err)
+		}
+	}
+	select {
+	case <-transport.Context().Done():
+	case <-ctx.Done():
+		t.Fatal("transport is not closed")
+	}
+	select {
+	case _, ok := <-subscription.C():
+		if ok {
+			t.Error("unexpected message")
+		}
+	case <-ctx.Done():
+		t.Error("subscription is not closed with the transport")
+	}
+}
+
+func TestBrokerRouting(t *testing.T) {
+	server, transport, ctx := dial(t)
+	var (
+		all     = transport.SubscribeWithOptions(cdp.SubscribeOptions{Size: 10})
+		session = transport.SubscribeWithOptions(cdp.SubscribeOptions{SessionID: "a", Size: 10})
+		domain  = transport.SubscribeWithOptions(cdp.SubscribeOptions{SessionID: "a", Methods: []string{"Page.*"}, Size: 10})
+		other   = transport.SubscribeWithOptions(cdp.SubscribeOptions{SessionID: "b", Size: 10})
+	)
+	for _, event := range []struct{ session, method string }{
+		{"a", "Page.loadEventFired"},
+		{"a", "Network.requestWillBeSent"},
+		{"b", "Page.loadEventFired"},
+		{"", "Target.targetCreated"},
+	} {
+		if err := server.Emit(event.session, event.method, struct{}{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the response is read after the events
+	if _, err := transport.Send(&cdp.Request{Method: "Test.sync"}).Get(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name         string
+		subscription *cdp.Subscription
+		want         []string
+	}{
+		{"all", all, []string{"a Page.loadEventFired", "a Network.requestWillBeSent", "b Page.loadEventFired", " Target.targetCreated"}},
+		{"session", session, []string{"a Page.loadEventFired", "a Network.requestWillBeSent", " Target.targetCreated"}},
+		{"domain", domain, []string{"a Page.loadEventFired"}},
+		{"other", other, []string{"b Page.loadEventFired", " Target.targetCreated"}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var got []string
+			for len(test.subscription.C()) > 0 {
+				message := <-test.subscription.C()
+				got = append(got, message.SessionID+" "+message.Method)
+			}
+			if strings.Join(got, ", ") != strings.Join(test.want, ", ") {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+			test.subscription.Cancel()
+		})
+	}
+}
+
+func TestBrokerOverflow(t *testing.T) {
+	server, transport, ctx := dial(t)
+	var (
+		byDefault  = transport.SubscribeWithOptions(cdp.SubscribeOptions{Size: 2})
+		dropOldest = transport.SubscribeWithOptions(cdp.SubscribeOptions{Size: 2, Overflow: cdp.OverflowDropOldest})
+		fail       = transport.SubscribeWithOptions(cdp.SubscribeOptions{Size: 2, Overflow: cdp.OverflowError})
+	)
+	defer byDefault.Cancel()
+	defer dropOldest.Cancel()
+	defer fail.Cancel()
+	for n := 0; n < 5; n++ {
+		if err := server.Emit("", "Test.event", map[string]int{"n": n}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := transport.Send(&cdp.Request{Method: "Test.sync"}).Get(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name         string
+		subscription *cdp.Subscription
+		want         []string
+		err          bool
+	}{
+		{"default", byDefault, []string{`{"n":3}`, `{"n":4}`}, false},
+		{"drop oldest", dropOldest, []string{`{"n":3}`, `{"n":4}`}, false},
+		{"error", fail, []string{`{"n":0}`, `{"n":1}`}, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var got []string
+			for len(test.subscription.C()) > 0 {
+				got = append(got, string((<-test.subscription.C()).Params))
+			}
+			if strings.Join(got, ", ") != strings.Join(test.want, ", ") {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+			if test.subscription.Dropped() != 3 {
+				t.Errorf("dropped %d, want 3", test.subscription.Dropped())
+			}
+			if err := test.subscription.Err(); errors.Is(err, cdp.ErrSubscriptionOverflow) != test.err {
+				t.Errorf("unexpected Err() %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+// Package cdptest provides an in-process fake DevTools server for unit tests
+package cdptest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
+	"github.com/retrozoid/control/cdp"
+)
+
+// Call is a request received by the server
+type Call struct {
+	ID        uint64
+	SessionID string
+	Method    string
+	Params    json.RawMessage
+}
+
+func (c Call) Unmarshal(v any) error {
+	if len(c.Params) == 0 {
+		return nil
+	}
+	return json.Unmarshal(c.Params, v)
+}
+
+// HandlerFunc returns the result of the call, *cdp.Error is sent with its code
+type HandlerFunc func(Call) (any, error)
+
+// Result responds with the value whatever the call is
+func Result(value any) HandlerFunc {
+	return func(Call) (any, error) { return value, nil }
+}
+
+// Fail responds with CDP error
+func Fail(code int, message string) HandlerFunc {
+	return func(Call) (any, error) { return nil, &cdp.Error{Code: code, Message: message} }
+}
+
+// NotFound responds like the browser to unknown method
+var NotFound HandlerFunc = func(c Call) (any, error) {
+	return nil, &cdp.Error{Code: -32601, Message: "'" + c.Method + "' wasn't found"}
+}
+
+// Server is a WebSocket server speaking CDP framing, unknown methods are answered with empty result
+type Server struct {
+	server   *httptest.Server
+	upgrader websocket.Upgrader
+	mutex    sync.Mutex
+	handlers map[string]HandlerFunc
+	fallback HandlerFunc
+	conns    map[*conn]struct{}
+	calls    []Call
+	notify   chan struct{}
+	seq      atomic.Uint64
+}
+
+type conn struct {
+	ws    *websocket.Conn
+	mutex sync.Mutex
+}
+
+func (c *conn) write(v any) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.ws.WriteJSON(v)
+}
+
+// NewServer starts the server with handlers of Target.attachToTarget, Target.createTarget and Target.getTargets
+func NewServer() *Server {
+	s := &Server{
+		handlers: map[string]HandlerFunc{},
+		fallback: Result(struct{}{}),
+		conns:    map[*conn]struct{}{},
+		notify:   make(chan struct{}),
+	}
+	s.Handle("Target.attachToTarget", func(c Call) (any, error) {
+		var args struct {
+			TargetId string `json:"targetId"`
+		}
+		if err := c.Unmarshal(&args); err != nil {
+			return nil, err
+		}
+		return map[string]string{"sessionId": SessionID(args.TargetId)}, nil
+	})
+	s.Handle("Target.createTarget", func(Call) (any, error) {
+		return map[string]string{"targetId": "target-" + strconv.FormatUint(s.seq.Add(1), 10)}, nil
+	})
+	s.Handle("Target.getTargets", Result(map[string]any{"targetInfos": []any{}}))
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", s.version)
+	mux.HandleFunc("/devtools/browser", s.serve)
+	s.server = httptest.NewServer(mux)
+	return s
+}
+
+// SessionID is the session id the default Target.attachToTarget handler responds with
+func SessionID(targetID string) string {
+	return "session-" + targetID
+}
+
+// URL returns ws:// address of the browser endpoint
+func (s *Server) URL() string {
+	return "ws" + strings.TrimPrefix(s.server.URL, "http") + "/devtools/browser"
+}
+
+// HTTPURL returns http:// address of the server that resolves /json/version
+func (s *Server) HTTPURL() string {
+	return s.server.URL
+}
+
+// Handle replaces the handler of the method
+func (s *Server) Handle(method string, handler HandlerFunc) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.handlers[method] = handler
+}
+
+// HandleNotFound replaces the handler of methods without their own one, e.g. NotFound
+func (s *Server) HandleNotFound(handler HandlerFunc) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.fallback = handler
+}
+
+// Dial connects cdp.Transport to the server
+func (s *Server) Dial(ctx context.Context) (*cdp.Transport, error) {
+	return cdp.DefaultDial(ctx, s.URL(), nil)
+}
+
+func (s *Server) version(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"Browser":              "cdptest",
+		"webSocketDebuggerUrl": s.URL(),
+	})
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	ws, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	c := &conn{ws: ws}
+	s.mutex.Lock()
+	s.conns[c] = struct{}{}
+	s.mutex.Unlock()
+	defer func() {
+		s.mutex.Lock()
+		delete(s.conns, c)
+		s.mutex.Unlock()
+		ws.Close()
+	}()
+	for {
+		var request cdp.Request
+		var params json.RawMessage
+		request.Params = &params
+		if err = ws.ReadJSON(&request); err != nil {
+			return
+		}
+		call := Call{ID: request.ID, SessionID: request.SessionID, Method: request.Method, Params: params}
+		s.mutex.Lock()
+		s.calls = append(s.calls, call)
+		close(s.notify)
+		s.notify = make(chan struct{})
+		handler, ok := s.handlers[call.Method]
+		if !ok {
+			handler = s.fallback
+		}
+		s.mutex.Unlock()
+		// handler may block, e.g. to respond out of order
+		go s.respond(c, call, handler)
+	}
+}
+
+func (s *Server) respond(c *conn, call Call, handler HandlerFunc) {
+	result, err := handler(call)
+	response := map[string]any{"id": call.ID}
+	if call.SessionID != "" {
+		response["sessionId"] = call.SessionID
+	}
+	if err != nil {
+		var cdpError *cdp.Error
+		if !errors.As(err, &cdpError) {
+			cdpError = &cdp.Error{Code: -32000, Message: err.Error()}
+		}
+		response["error"] = cdpError
+	} else {
+		if result == nil {
+			result = struct{}{}
+		}
+		response["result"] = result
+	}
+	_ = c.write(response)
+}
+
+// Emit pushes the event to all connected clients
+func (s *Server) Emit(sessionID, method string, params any) error {
+	message := map[string]any{"method": method, "params": params}
+	if sessionID != "" {
+		message["sessionId"] = sessionID
+	}
+	return s.Send(message)
+}
+
+// Send pushes the raw message to all connected clients, e.g. the response with unknown id
+func (s *Server) Send(message any) error {
+	s.mutex.Lock()
+	conns := make([]*conn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	s.mutex.Unlock()
+	var err error
+	for _, c := range conns {
+		err = errors.Join(err, c.write(message))
+	}
+	return err
+}
+
+// Calls returns the received calls of the methods in order, all of them if methods are omitted
+func (s *Server) Calls(methods ...string) []Call {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var calls []Call
+	for _, call := range s.calls {
+		if len(methods) == 0 || slices.Contains(methods, call.Method) {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// WaitCall waits for the first call of the method
+func (s *Server) WaitCall(ctx context.Context, method string) (Call, error) {
+	for {
+		s.mutex.Lock()
+		notify := s.notify
+		for _, call := range s.calls {
+			if call.Method == method {
+				s.mutex.Unlock()
+				return call, nil
+			}
+		}
+		s.mutex.Unlock()
+		select {
+		case <-ctx.Done():
+			return Call{}, context.Cause(ctx)
+		case <-notify:
+		}
+	}
+}
+
+// Reset forgets the received calls
+func (s *Server) Reset() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.calls = nil
+}
+
+// Disconnect drops all connections abruptly without close frame
+func (s *Server) Disconnect() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for c := range s.conns {
+		_ = c.ws.UnderlyingConn().Close()
+	}
+}
+
+func (s *Server) Close() {
+	s.Disconnect()
+	s.server.Close()
+}
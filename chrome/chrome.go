@@ -4,24 +4,65 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 var MaxTimeToStart = 10 * time.Second
 
+var ErrBinaryNotFound = errors.New("chrome binary not found")
+
 type Chrome struct {
 	WebSocketUrl string
 	UserDataDir  string
 	StartArgs    string
 	cmd          *exec.Cmd
+	pipe         *pipe
+	exited       *exited
+}
+
+// exited is closed when the browser process is finished and its temporary profile is removed
+type exited struct {
+	done    chan struct{}
+	err     error
+	cleanup error
+}
+
+// notStarted is the exited channel of the browser which process isn't started
+var notStarted = func() chan struct{} {
+	done := make(chan struct{})
+	close(done)
+	return done
+}()
+
+// pipe is the client side of --remote-debugging-pipe,
+// browser reads commands from fd 3 and writes responses to fd 4
+type pipe struct {
+	reader *os.File
+	writer *os.File
+}
+
+func (p *pipe) Read(b []byte) (int, error) {
+	return p.reader.Read(b)
+}
+
+func (p *pipe) Write(b []byte) (int, error) {
+	return p.writer.Write(b)
+}
+
+func (p *pipe) Close() error {
+	return errors.Join(p.writer.Close(), p.reader.Close())
 }
 
 type Target struct {
@@ -61,84 +102,327 @@ func (c Chrome) NewTab(cli *http.Client, address string) (target Target, err err
 	return
 }
 
+// Pipe returns the connection of the browser launched with --remote-debugging-pipe
+func (c Chrome) Pipe() io.ReadWriteCloser {
+	if c.pipe == nil {
+		return nil
+	}
+	return c.pipe
+}
+
+// WaitCloseGracefully waits for the browser process to exit
 func (c Chrome) WaitCloseGracefully() error {
-	defer func() {
-		err := os.RemoveAll(c.UserDataDir)
-		if err != nil {
-			log.Println(err)
+	if c.exited == nil {
+		return nil
+	}
+	<-c.exited.done
+	return c.exited.err
+}
+
+// Exited is closed when the browser process is finished, e.g. crashed
+func (c Chrome) Exited() <-chan struct{} {
+	if c.exited == nil {
+		return notStarted
+	}
+	return c.exited.done
+}
+
+// Kill kills the browser with its process group and waits for the temporary profile to be removed
+func (c Chrome) Kill() error {
+	if c.exited == nil {
+		return nil
+	}
+	select {
+	case <-c.exited.done:
+	default:
+		if err := c.cmd.Cancel(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			return err
 		}
-	}()
-	return c.cmd.Wait()
+		<-c.exited.done
+	}
+	return c.exited.cleanup
 }
 
-func bin() string {
-	for _, path := range []string{
+var knownBinaries = map[string][]string{
+	"darwin": {
 		"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
-		"/usr/bin/google-chrome",
-		"headless-shell",
-		"browser",
-		"chromium",
-		"chromium-browser",
-		"google-chrome",
-		"google-chrome-stable",
-		"google-chrome-beta",
-		"google-chrome-unstable",
-	} {
+		"/Applications/Google Chrome Canary.app/Contents/MacOS/Google Chrome Canary",
+		"/Applications/Chromium.app/Contents/MacOS/Chromium",
+	},
+	"windows": {
+		`C:\Program Files\Google\Chrome\Application\chrome.exe`,
+		`C:\Program Files (x86)\Google\Chrome\Application\chrome.exe`,
+		`C:\Program Files\Chromium\Application\chrome.exe`,
+		"chrome.exe",
+	},
+}
+
+var defaultBinaries = []string{
+	"/usr/bin/google-chrome",
+	"headless-shell",
+	"browser",
+	"chromium",
+	"chromium-browser",
+	"google-chrome",
+	"google-chrome-stable",
+	"google-chrome-beta",
+	"google-chrome-unstable",
+	"/snap/bin/chromium",
+}
+
+// bin looks for the browser binary: explicit path, BinaryPathEnv variable, then well-known locations
+func bin(explicit string) (string, error) {
+	if explicit == "" {
+		explicit = os.Getenv(BinaryPathEnv)
+	}
+	if explicit != "" {
+		path, err := exec.LookPath(explicit)
+		if err != nil {
+			return "", errors.Join(ErrBinaryNotFound, err)
+		}
+		return path, nil
+	}
+	for _, path := range append(knownBinaries[runtime.GOOS], defaultBinaries...) {
 		if _, err := exec.LookPath(path); err == nil {
-			return path
+			return path, nil
 		}
 	}
-	panic("chrome binary not found")
+	return "", ErrBinaryNotFound
 }
 
-func Launch(ctx context.Context, userFlags ...string) (value Chrome, err error) {
-	if value.UserDataDir, err = os.MkdirTemp("", "chrome-control-*"); err != nil {
-		return value, err
-	}
+func (opts LaunchOptions) flags(userDataDir string) []string {
 	// https://github.com/GoogleChrome/chrome-launcher/blob/master/docs/chrome-flags-for-tools.md
 	// https://docs.google.com/spreadsheets/d/1n-vw_PCPS45jX3Jt9jQaAhFqBY6Ge1vWF_Pa0k7dCk4/edit#gid=1265672696
-	flags := []string{
-		"--remote-debugging-port=0",
-		"--user-data-dir=" + value.UserDataDir,
+	flags := []string{"--user-data-dir=" + userDataDir}
+	if opts.Pipe {
+		flags = append(flags, "--remote-debugging-pipe")
+	} else {
+		flags = append(flags, "--remote-debugging-port=0")
+	}
+	switch opts.Headless {
+	case HeadlessNew:
+		flags = append(flags, "--headless=new")
+	case HeadlessOld:
+		flags = append(flags, "--headless=old")
+	}
+	if opts.WindowWidth > 0 && opts.WindowHeight > 0 {
+		flags = append(flags, "--window-size="+strconv.Itoa(opts.WindowWidth)+","+strconv.Itoa(opts.WindowHeight))
+	}
+	if opts.Proxy != "" {
+		flags = append(flags, "--proxy-server="+opts.Proxy)
+	}
+	if opts.ProxyBypassList != "" {
+		flags = append(flags, "--proxy-bypass-list="+opts.ProxyBypassList)
 	}
-	if len(userFlags) > 0 {
-		flags = append(flags, userFlags...)
+	if len(opts.Extensions) > 0 {
+		extensions := strings.Join(opts.Extensions, ",")
+		flags = append(flags, "--load-extension="+extensions, "--disable-extensions-except="+extensions)
 	}
+	flags = append(flags, opts.Flags...)
 	if os.Getuid() == 0 {
 		flags = append(flags, "--no-sandbox", "--disable-setuid-sandbox")
 	}
-	binary := bin()
-	value.StartArgs = fmt.Sprint(binary, strings.Join(flags, " "))
-	value.cmd = exec.CommandContext(ctx, binary, flags...)
+	return flags
+}
 
-	stderr, err := value.cmd.StderrPipe()
+// userDataDir returns the profile directory and whether it's temporary
+func (opts LaunchOptions) userDataDir() (string, bool, error) {
+	if opts.UserDataDir != "" {
+		return opts.UserDataDir, false, nil
+	}
+	dir, err := os.MkdirTemp("", "chrome-control-*")
 	if err != nil {
-		return value, err
+		return "", false, err
 	}
+	if opts.TemplateUserDataDir != "" {
+		if err = copyDir(opts.TemplateUserDataDir, dir); err != nil {
+			return "", false, errors.Join(err, os.RemoveAll(dir))
+		}
+	}
+	return dir, true, nil
+}
 
-	addr := make(chan string)
-	var std []string
-	go func() {
-		const prefix = "DevTools listening on"
-		var scanner = bufio.NewScanner(stderr)
-		for scanner.Scan() {
-			line := scanner.Text()
-			std = append(std, line)
-			if s := strings.TrimPrefix(line, prefix); s != line {
-				addr <- strings.TrimSpace(s)
-				return
-			}
+// copyDir copies the profile skipping the lock files of a running browser
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
 		}
-	}()
+		if strings.HasPrefix(d.Name(), "Singleton") {
+			return nil
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o700)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			return err
+		}
+		if _, err = io.Copy(out, in); err != nil {
+			out.Close()
+			return err
+		}
+		return out.Close()
+	})
+}
+
+func Launch(ctx context.Context, userFlags ...string) (Chrome, error) {
+	return LaunchWithOptions(ctx, LaunchOptions{Flags: userFlags})
+}
 
-	if err = value.cmd.Start(); err != nil {
+// LaunchPipe starts the browser with --remote-debugging-pipe, there is no debugging port open
+func LaunchPipe(ctx context.Context, userFlags ...string) (Chrome, error) {
+	return LaunchWithOptions(ctx, LaunchOptions{Flags: userFlags, Pipe: true})
+}
+
+// LaunchWithOptions starts the browser, the whole process group is killed when the context is done.
+// Temporary profile is removed after the browser exits whatever the reason is
+func LaunchWithOptions(ctx context.Context, opts LaunchOptions) (value Chrome, err error) {
+	binary, err := bin(opts.BinaryPath)
+	if err != nil {
 		return value, err
 	}
+	userDataDir, temporary, err := opts.userDataDir()
+	if err != nil {
+		return value, err
+	}
+	cleanup := func() error {
+		if temporary {
+			return os.RemoveAll(userDataDir)
+		}
+		return nil
+	}
+
+	flags := opts.flags(userDataDir)
+	value.UserDataDir = userDataDir
+	value.StartArgs = fmt.Sprint(binary, " ", strings.Join(flags, " "))
+	value.cmd = exec.CommandContext(ctx, binary, flags...)
+	value.cmd.Env = append(os.Environ(), opts.Env...)
+	value.cmd.Stdout = opts.Stdout
+	value.cmd.WaitDelay = 5 * time.Second
+	setProcessGroup(value.cmd)
 
+	var (
+		stderr          = opts.Stderr
+		stderrTail      = &lastLines{}
+		addr            = make(chan string, 1)
+		closeAfterStart []*os.File
+	)
+	if stderr == nil {
+		stderr = io.Discard
+	}
+	if opts.Pipe {
+		// browser side: reads from fd 3, writes to fd 4
+		commandsReader, commandsWriter, err := os.Pipe()
+		if err != nil {
+			return value, errors.Join(err, cleanup())
+		}
+		responsesReader, responsesWriter, err := os.Pipe()
+		if err != nil {
+			return value, errors.Join(err, commandsReader.Close(), commandsWriter.Close(), cleanup())
+		}
+		value.cmd.ExtraFiles = []*os.File{commandsReader, responsesWriter}
+		value.cmd.Stderr = stderr
+		value.pipe = &pipe{reader: responsesReader, writer: commandsWriter}
+		closeAfterStart = value.cmd.ExtraFiles
+	} else {
+		reader, writer, err := os.Pipe()
+		if err != nil {
+			return value, errors.Join(err, cleanup())
+		}
+		value.cmd.Stderr = writer
+		closeAfterStart = []*os.File{writer}
+		go scanWebSocketURL(reader, stderr, stderrTail, addr)
+	}
+
+	err = value.cmd.Start()
+	// the child process has its own copies
+	for _, file := range closeAfterStart {
+		err = errors.Join(err, file.Close())
+	}
+	if err != nil {
+		if value.pipe != nil {
+			err = errors.Join(err, value.pipe.Close())
+		}
+		return value, errors.Join(err, cleanup())
+	}
+
+	// exited is set for the started process only, a browser failed to start has nothing to wait for
+	value.exited = &exited{done: make(chan struct{})}
+	go func() {
+		err := value.cmd.Wait()
+		value.exited.cleanup = cleanup()
+		value.exited.err = errors.Join(err, value.exited.cleanup)
+		close(value.exited.done)
+	}()
+	if opts.Pipe {
+		return value, nil
+	}
+
+	timeout := opts.StartTimeout
+	if timeout == 0 {
+		timeout = MaxTimeToStart
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
 	select {
 	case value.WebSocketUrl = <-addr:
 		return value, nil
-	case <-time.After(MaxTimeToStart):
-		return value, fmt.Errorf("chrome stopped too early %s", strings.Join(std, "\n"))
+	case <-value.exited.done:
+		return value, fmt.Errorf("chrome stopped too early %s: %w", stderrTail, value.exited.err)
+	case <-timer.C:
+		return value, errors.Join(fmt.Errorf("chrome didn't start in %s %s", timeout, stderrTail), value.Kill())
+	}
+}
+
+// lastLines keeps the tail of browser stderr to report why it failed to start
+type lastLines struct {
+	mutex sync.Mutex
+	lines []string
+}
+
+func (l *lastLines) add(line string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if len(l.lines) == 20 {
+		l.lines = l.lines[1:]
+	}
+	l.lines = append(l.lines, line)
+}
+
+func (l *lastLines) String() string {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return strings.Join(l.lines, "\n")
+}
+
+// scanWebSocketURL copies browser stderr to the writer and reports the address of DevTools listening
+func scanWebSocketURL(stderr io.ReadCloser, w io.Writer, tail *lastLines, addr chan<- string) {
+	defer stderr.Close()
+	const prefix = "DevTools listening on"
+	var scanner = bufio.NewScanner(stderr)
+	for scanner.Scan() {
+		line := scanner.Text()
+		tail.add(line)
+		fmt.Fprintln(w, line)
+		if s := strings.TrimPrefix(line, prefix); s != line {
+			select {
+			case addr <- strings.TrimSpace(s):
+			default:
+			}
+		}
 	}
 }
@@ -0,0 +1,45 @@
+package chrome
+
+import (
+	"io"
+	"time"
+)
+
+// BinaryPathEnv is the environment variable overriding the browser binary lookup
+const BinaryPathEnv = "CHROME_PATH"
+
+type HeadlessMode int
+
+const (
+	Headful HeadlessMode = iota
+	HeadlessNew
+	HeadlessOld
+)
+
+type LaunchOptions struct {
+	// BinaryPath is the browser executable, BinaryPathEnv and well-known locations are looked up if it's empty
+	BinaryPath string
+	Headless   HeadlessMode
+	// WindowWidth and WindowHeight set --window-size if both are positive
+	WindowWidth     int
+	WindowHeight    int
+	Proxy           string
+	ProxyBypassList string
+	// Extensions are paths to unpacked extensions to load
+	Extensions []string
+	// UserDataDir is a persistent profile, it is kept after the browser exits
+	UserDataDir string
+	// TemplateUserDataDir is copied into a temporary profile if UserDataDir is empty
+	TemplateUserDataDir string
+	// Env is appended to the environment of the current process
+	Env []string
+	// Stdout and Stderr receive the browser output, it is discarded if they are nil
+	Stdout io.Writer
+	Stderr io.Writer
+	// Pipe uses --remote-debugging-pipe instead of the debugging port
+	Pipe bool
+	// StartTimeout bounds waiting for the debugging port, MaxTimeToStart is used if it's zero
+	StartTimeout time.Duration
+	// Flags are appended to the command line
+	Flags []string
+}
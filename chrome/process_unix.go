@@ -0,0 +1,16 @@
+//go:build unix
+
+package chrome
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup makes the browser a process group leader to kill its renderers and helpers together with it
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
@@ -0,0 +1,189 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/retrozoid/control/cdp"
+	"github.com/retrozoid/control/protocol/target"
+)
+
+// Connection is a connection to an already running browser
+type Connection struct {
+	transport *cdp.Transport
+	timeouts  *timeoutsState
+	caller    context.Context
+	// attached is set when the browser isn't launched by the connection, its existing pages aren't closed by Session.Close
+	attached bool
+}
+
+// Connect attaches to the browser by http://host:port of its remote debugging server or by ws:// URL of its DevTools endpoint
+func Connect(ctx context.Context, endpoint string) (*Connection, error) {
+	return ConnectWithLogger(ctx, endpoint, nil)
+}
+
+func ConnectWithLogger(ctx context.Context, endpoint string, logger *slog.Logger) (*Connection, error) {
+	webSocketURL, err := ResolveWebSocketURL(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := cdp.DefaultDial(ctx, webSocketURL, logger)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("websocket dial failed"))
+	}
+	conn := NewConnection(transport)
+	conn.attached = true
+	return conn, nil
+}
+
+// ResolveWebSocketURL requests /json/version of http(s) endpoint for webSocketDebuggerUrl,
+// ws(s) endpoint is returned as is
+func ResolveWebSocketURL(ctx context.Context, endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	switch u.Scheme {
+	case "ws", "wss":
+		return endpoint, nil
+	case "http", "https":
+	default:
+		return "", fmt.Errorf("unsupported endpoint scheme `%s`", u.Scheme)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(endpoint, "/")+"/json/version", nil)
+	if err != nil {
+		return "", err
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s responded with %s", request.URL, response.Status)
+	}
+	var version struct {
+		WebSocketDebuggerUrl string `json:"webSocketDebuggerUrl"`
+	}
+	if err = json.NewDecoder(response.Body).Decode(&version); err != nil {
+		return "", err
+	}
+	ws, err := url.Parse(version.WebSocketDebuggerUrl)
+	if err != nil {
+		return "", err
+	}
+	// browser reports its own listening address, which is unreachable from outside of a container
+	ws.Host = u.Host
+	if u.Scheme == "https" {
+		ws.Scheme = "wss"
+	}
+	return ws.String(), nil
+}
+
+// NewConnection wraps the transport made over custom cdp.Conn, e.g. recording or replaying one
+func NewConnection(transport *cdp.Transport) *Connection {
+	return &Connection{
+		transport: transport,
+		timeouts:  newTimeoutsState(DefaultTimeouts),
+	}
+}
+
+func (c *Connection) Transport() *cdp.Transport {
+	return c.transport
+}
+
+// Call sends the browser level command bounded by the timeouts of the connection and the context of the view
+func (c *Connection) Call(method string, send, recv any) error {
+	if err := callerDone(c.caller); err != nil {
+		return err
+	}
+	future := c.transport.Send(&cdp.Request{
+		Method: method,
+		Params: send,
+	})
+	defer future.Cancel()
+
+	ctx, cancel := withCaller(c.transport.Context(), c.caller, c.Timeouts().method(method))
+	defer cancel()
+	value, err := future.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if recv != nil {
+		return json.Unmarshal(value.Result, recv)
+	}
+	return nil
+}
+
+// Pages returns the page targets of the browser
+func (c *Connection) Pages() Optional[[]*target.TargetInfo] {
+	val, err := target.GetTargets(c, target.GetTargetsArgs{})
+	if err != nil {
+		return Optional[[]*target.TargetInfo]{err: err}
+	}
+	var pages []*target.TargetInfo
+	for _, info := range val.TargetInfos {
+		if info.Type == "page" {
+			pages = append(pages, info)
+		}
+	}
+	return Optional[[]*target.TargetInfo]{value: pages}
+}
+
+// Attach controls the existing page, Session.Close detaches from the page of the browser made by Connect
+func (c *Connection) Attach(id target.TargetID) (*Session, error) {
+	session, err := c.newSession(id)
+	if err != nil {
+		return nil, err
+	}
+	session.state.detachOnClose = c.attached
+	return session, nil
+}
+
+func (c *Connection) MustAttach(id target.TargetID) *Session {
+	s, err := c.Attach(id)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// NewPage opens a new tab and attaches to it, Session.Close closes the tab
+func (c *Connection) NewPage(url string) (*Session, error) {
+	if url == "" {
+		url = Blank // headless chrome crash when url is empty
+	}
+	r, err := target.CreateTarget(c, target.CreateTargetArgs{Url: url})
+	if err != nil {
+		return nil, err
+	}
+	// the page is opened by us, it's closed by Session.Close
+	return c.newSession(r.TargetId)
+}
+
+func (c *Connection) newSession(id target.TargetID) (*Session, error) {
+	return newSession(c.transport, id, c.caller, c.Timeouts())
+}
+
+func (c *Connection) withContext(ctx context.Context) contextCaller {
+	return c.WithContext(ctx)
+}
+
+func (c *Connection) MustNewPage(url string) *Session {
+	s, err := c.NewPage(url)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Close detaches all sessions of the connection, the browser and its pages are left running
+func (c *Connection) Close() error {
+	return c.transport.Disconnect()
+}
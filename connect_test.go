@@ -0,0 +1,82 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/retrozoid/control/cdptest"
+)
+
+// hang blocks the calls of the method until the end of the test
+func hang(t *testing.T, server *cdptest.Server, method string) {
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+	server.Handle(method, func(cdptest.Call) (any, error) {
+		<-release
+		return nil, nil
+	})
+}
+
+func TestConnectionTimeouts(t *testing.T) {
+	server := cdptest.NewServer()
+	t.Cleanup(server.Close)
+	hang(t, server, "Test.hang")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := Connect(ctx, server.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	conn.SetTimeouts(Timeouts{Default: 50 * time.Millisecond})
+	if err = conn.Call("Test.hang", nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("call isn't bound by the timeout of the connection: %v", err)
+	}
+	session, err := conn.NewPage(Blank)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Timeouts().Default != 50*time.Millisecond {
+		t.Errorf("session doesn't inherit the timeouts of the connection: %+v", session.Timeouts())
+	}
+
+	stop := errors.New("stop")
+	callerCtx, cancelCaller := context.WithCancelCause(ctx)
+	view := conn.WithContext(callerCtx)
+	view.SetTimeout(time.Minute)
+	time.AfterFunc(10*time.Millisecond, func() { cancelCaller(stop) })
+	if err = view.Call("Test.hang", nil, nil); !errors.Is(err, stop) {
+		t.Errorf("call isn't cancelled with the context of the view: %v", err)
+	}
+	if _, err = view.NewPage(Blank); !errors.Is(err, stop) {
+		t.Errorf("page is opened through the cancelled view: %v", err)
+	}
+	if conn.Timeouts().Default != 50*time.Millisecond {
+		t.Errorf("view replaced the timeouts of the connection: %+v", conn.Timeouts())
+	}
+	if err = conn.Call("Test.sync", nil, nil); err != nil {
+		t.Errorf("connection is cancelled with its view: %v", err)
+	}
+}
+
+func TestConnectionAttachBoundByView(t *testing.T) {
+	server := cdptest.NewServer()
+	t.Cleanup(server.Close)
+	hang(t, server, "Page.enable")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := Connect(ctx, server.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	callerCtx, cancelCaller := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancelCaller()
+	if _, err = conn.WithContext(callerCtx).NewPage(Blank); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("attaching isn't bound by the context of the view: %v", err)
+	}
+}
@@ -0,0 +1,199 @@
+package control
+
+import (
+	"context"
+	"errors"
+
+	"github.com/retrozoid/control/protocol"
+	"github.com/retrozoid/control/protocol/browser"
+	"github.com/retrozoid/control/protocol/common"
+	"github.com/retrozoid/control/protocol/network"
+	"github.com/retrozoid/control/protocol/storage"
+	"github.com/retrozoid/control/protocol/target"
+)
+
+// browserCaller sends commands to the browser target instead of the session one
+type browserCaller struct {
+	session *Session
+}
+
+func (b browserCaller) Call(method string, send, recv any) error {
+	return b.session.call("", method, send, recv)
+}
+
+func (b browserCaller) newSession(id target.TargetID) (*Session, error) {
+	return newSession(b.session.transport, id, b.session.caller, b.session.Timeouts())
+}
+
+func (b browserCaller) withContext(ctx context.Context) contextCaller {
+	return browserCaller{session: b.session.WithContext(ctx)}
+}
+
+// contextCaller sends browser level commands and attaches to the created pages
+type contextCaller interface {
+	protocol.Caller
+	newSession(id target.TargetID) (*Session, error)
+	withContext(ctx context.Context) contextCaller
+}
+
+type BrowserContextOptions struct {
+	ProxyServer     string
+	ProxyBypassList string
+	// DownloadPath allows downloads to the path if set
+	DownloadPath string
+	// Permissions are granted to PermissionsOrigin, to all origins if it's empty
+	Permissions       []browser.PermissionType
+	PermissionsOrigin string
+	Cookies           []*network.CookieParam
+	DisposeOnDetach   bool
+}
+
+// BrowserContext is an isolated incognito-like profile with its own cookies, storage and cache
+type BrowserContext struct {
+	caller contextCaller
+	id     common.BrowserContextID
+}
+
+func (s *Session) NewBrowserContext(opts BrowserContextOptions) (*BrowserContext, error) {
+	return newBrowserContext(browserCaller{session: s}, opts)
+}
+
+func (c *Connection) NewBrowserContext(opts BrowserContextOptions) (*BrowserContext, error) {
+	return newBrowserContext(c, opts)
+}
+
+func newBrowserContext(caller contextCaller, opts BrowserContextOptions) (*BrowserContext, error) {
+	val, err := target.CreateBrowserContext(caller, target.CreateBrowserContextArgs{
+		DisposeOnDetach: opts.DisposeOnDetach,
+		ProxyServer:     opts.ProxyServer,
+		ProxyBypassList: opts.ProxyBypassList,
+	})
+	if err != nil {
+		return nil, err
+	}
+	c := &BrowserContext{caller: caller, id: val.BrowserContextId}
+	if err = c.setup(opts); err != nil {
+		return nil, errors.Join(err, c.Dispose())
+	}
+	return c, nil
+}
+
+func (s *Session) MustNewBrowserContext(opts BrowserContextOptions) *BrowserContext {
+	c, err := s.NewBrowserContext(opts)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func (c *BrowserContext) setup(opts BrowserContextOptions) error {
+	if opts.DownloadPath != "" {
+		if err := c.SetDownloadBehavior("allow", opts.DownloadPath, false); err != nil {
+			return err
+		}
+	}
+	if len(opts.Permissions) > 0 {
+		if err := c.GrantPermissions(opts.PermissionsOrigin, opts.Permissions...); err != nil {
+			return err
+		}
+	}
+	if len(opts.Cookies) > 0 {
+		if err := c.SetCookies(opts.Cookies...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *BrowserContext) GetID() common.BrowserContextID {
+	return c.id
+}
+
+// NewPage opens a new tab in the context and attaches to it
+func (c *BrowserContext) NewPage(url string) (*Session, error) {
+	if url == "" {
+		url = Blank // headless chrome crash when url is empty
+	}
+	r, err := target.CreateTarget(c.caller, target.CreateTargetArgs{
+		Url:              url,
+		BrowserContextId: c.id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.caller.newSession(r.TargetId)
+}
+
+func (c *BrowserContext) MustNewPage(url string) *Session {
+	s, err := c.NewPage(url)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Pages returns the page targets opened in the context
+func (c *BrowserContext) Pages() Optional[[]*target.TargetInfo] {
+	val, err := target.GetTargets(c.caller, target.GetTargetsArgs{})
+	if err != nil {
+		return Optional[[]*target.TargetInfo]{err: err}
+	}
+	var pages []*target.TargetInfo
+	for _, info := range val.TargetInfos {
+		if info.Type == "page" && info.BrowserContextId == c.id {
+			pages = append(pages, info)
+		}
+	}
+	return Optional[[]*target.TargetInfo]{value: pages}
+}
+
+func (c *BrowserContext) SetDownloadBehavior(behavior string, downloadPath string, eventsEnabled bool) error {
+	return browser.SetDownloadBehavior(c.caller, browser.SetDownloadBehaviorArgs{
+		Behavior:         behavior,
+		BrowserContextId: c.id,
+		DownloadPath:     downloadPath,
+		EventsEnabled:    eventsEnabled,
+	})
+}
+
+func (c *BrowserContext) GrantPermissions(origin string, permissions ...browser.PermissionType) error {
+	return browser.GrantPermissions(c.caller, browser.GrantPermissionsArgs{
+		Permissions:      permissions,
+		Origin:           origin,
+		BrowserContextId: c.id,
+	})
+}
+
+func (c *BrowserContext) ResetPermissions() error {
+	return browser.ResetPermissions(c.caller, browser.ResetPermissionsArgs{BrowserContextId: c.id})
+}
+
+func (c *BrowserContext) GetCookies() Optional[[]*network.Cookie] {
+	val, err := storage.GetCookies(c.caller, storage.GetCookiesArgs{BrowserContextId: c.id})
+	if err != nil {
+		return Optional[[]*network.Cookie]{err: err}
+	}
+	return Optional[[]*network.Cookie]{value: val.Cookies}
+}
+
+func (c *BrowserContext) SetCookies(cookies ...*network.CookieParam) error {
+	return storage.SetCookies(c.caller, storage.SetCookiesArgs{
+		Cookies:          cookies,
+		BrowserContextId: c.id,
+	})
+}
+
+func (c *BrowserContext) ClearCookies() error {
+	return storage.ClearCookies(c.caller, storage.ClearCookiesArgs{BrowserContextId: c.id})
+}
+
+// Dispose closes all pages of the context and deletes its data
+func (c *BrowserContext) Dispose() error {
+	return target.DisposeBrowserContext(c.caller, target.DisposeBrowserContextArgs{BrowserContextId: c.id})
+}
+
+func (c *BrowserContext) MustDispose() {
+	if err := c.Dispose(); err != nil {
+		panic(err)
+	}
+}
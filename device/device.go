@@ -0,0 +1,183 @@
+// Package device is the registry of emulated devices, see control.Session.Emulate
+package device
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/retrozoid/control/protocol/common"
+)
+
+type Orientation string
+
+const (
+	Portrait  Orientation = "portrait"
+	Landscape Orientation = "landscape"
+)
+
+// DefaultMaxTouchPoints is reported by touch devices which don't set MaxTouchPoints
+var DefaultMaxTouchPoints = 5
+
+// Device is described in portrait orientation, sizes are in CSS pixels
+type Device struct {
+	Name string `json:"name"`
+	// Width and Height of the viewport
+	Width  int `json:"width"`
+	Height int `json:"height"`
+	// ScreenWidth and ScreenHeight are the size of the viewport if they are zero
+	ScreenWidth       int     `json:"screenWidth,omitempty"`
+	ScreenHeight      int     `json:"screenHeight,omitempty"`
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+	Mobile            bool    `json:"mobile"`
+	Touch             bool    `json:"touch"`
+	MaxTouchPoints    int     `json:"maxTouchPoints,omitempty"`
+	// UserAgent isn't overridden if it's empty
+	UserAgent string `json:"userAgent,omitempty"`
+	// UserAgentMetadata are client hints, they are disabled if it's nil as Safari and Firefox do
+	UserAgentMetadata *common.UserAgentMetadata `json:"userAgentMetadata,omitempty"`
+	// Orientation is Portrait if it's empty
+	Orientation Orientation `json:"orientation,omitempty"`
+}
+
+// Rotate returns the device in the orientation
+func (d Device) Rotate(orientation Orientation) Device {
+	d.Orientation = orientation
+	return d
+}
+
+func (d Device) IsLandscape() bool {
+	return d.Orientation == Landscape
+}
+
+// Viewport returns the size of the viewport in the current orientation
+func (d Device) Viewport() (width, height int) {
+	if d.IsLandscape() {
+		return d.Height, d.Width
+	}
+	return d.Width, d.Height
+}
+
+// Screen returns the size of the screen in the current orientation
+func (d Device) Screen() (width, height int) {
+	width, height = d.ScreenWidth, d.ScreenHeight
+	if width == 0 || height == 0 {
+		width, height = d.Width, d.Height
+	}
+	if d.IsLandscape() {
+		return height, width
+	}
+	return width, height
+}
+
+// ScreenOrientation returns the type and the angle of screen.orientation
+func (d Device) ScreenOrientation() (kind string, angle int) {
+	if d.IsLandscape() {
+		return "landscapePrimary", 90
+	}
+	return "portraitPrimary", 0
+}
+
+func (d Device) TouchPoints() int {
+	if !d.Touch {
+		return 0
+	}
+	if d.MaxTouchPoints > 0 {
+		return d.MaxTouchPoints
+	}
+	return DefaultMaxTouchPoints
+}
+
+func (d Device) Validate() error {
+	if d.Name == "" {
+		return errors.New("device has no name")
+	}
+	if d.Width <= 0 || d.Height <= 0 {
+		return fmt.Errorf("device `%s` has no viewport size", d.Name)
+	}
+	switch d.Orientation {
+	case "", Portrait, Landscape:
+	default:
+		return fmt.Errorf("device `%s` has unknown orientation `%s`", d.Name, d.Orientation)
+	}
+	return nil
+}
+
+var registry = struct {
+	mutex   sync.RWMutex
+	devices map[string]Device
+}{devices: map[string]Device{}}
+
+// Register adds the devices to the registry replacing the ones of the same name
+func Register(devices ...Device) error {
+	for _, d := range devices {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+	}
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	for _, d := range devices {
+		registry.devices[d.Name] = d
+	}
+	return nil
+}
+
+func Lookup(name string) (Device, bool) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	d, ok := registry.devices[name]
+	return d, ok
+}
+
+func MustLookup(name string) Device {
+	d, ok := Lookup(name)
+	if !ok {
+		panic(fmt.Errorf("unknown device `%s`", name))
+	}
+	return d
+}
+
+// Names returns the sorted names of registered devices
+func Names() []string {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	names := make([]string, 0, len(registry.devices))
+	for name := range registry.devices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Load registers the devices of JSON array
+func Load(r io.Reader) ([]Device, error) {
+	var devices []Device
+	if err := json.NewDecoder(r).Decode(&devices); err != nil {
+		return nil, err
+	}
+	return devices, Register(devices...)
+}
+
+func LoadFile(path string) ([]Device, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return Load(file)
+}
+
+func init() {
+	if err := Register(
+		IPhoneSE, IPhone15, IPhone15ProMax,
+		Pixel7, Pixel8, GalaxyS23,
+		IPadMini, IPadPro11, GalaxyTabS9,
+	); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,131 @@
+package device
+
+import "github.com/retrozoid/control/protocol/common"
+
+const (
+	safariIPhone = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Mobile/15E148 Safari/604.1"
+	safariIPad   = "Mozilla/5.0 (iPad; CPU OS 17_5 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.5 Mobile/15E148 Safari/604.1"
+	// Chrome reduces user agent on Android, the model is reported by client hints only
+	chromeAndroidMobile = "Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Mobile Safari/537.36"
+	chromeAndroidTablet = "Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36"
+)
+
+func chromeAndroid(model, platformVersion string, mobile bool) *common.UserAgentMetadata {
+	return &common.UserAgentMetadata{
+		Brands: []*common.UserAgentBrandVersion{
+			{Brand: "Not/A)Brand", Version: "8"},
+			{Brand: "Chromium", Version: "126"},
+			{Brand: "Google Chrome", Version: "126"},
+		},
+		FullVersionList: []*common.UserAgentBrandVersion{
+			{Brand: "Not/A)Brand", Version: "8.0.0.0"},
+			{Brand: "Chromium", Version: "126.0.6478.71"},
+			{Brand: "Google Chrome", Version: "126.0.6478.71"},
+		},
+		FullVersion:     "126.0.6478.71",
+		Platform:        "Android",
+		PlatformVersion: platformVersion,
+		Model:           model,
+		Mobile:          mobile,
+	}
+}
+
+var (
+	IPhoneSE = Device{
+		Name:              "iPhone SE",
+		Width:             375,
+		Height:            548,
+		ScreenWidth:       375,
+		ScreenHeight:      667,
+		DeviceScaleFactor: 2,
+		Mobile:            true,
+		Touch:             true,
+		UserAgent:         safariIPhone,
+	}
+	IPhone15 = Device{
+		Name:              "iPhone 15",
+		Width:             393,
+		Height:            659,
+		ScreenWidth:       393,
+		ScreenHeight:      852,
+		DeviceScaleFactor: 3,
+		Mobile:            true,
+		Touch:             true,
+		UserAgent:         safariIPhone,
+	}
+	IPhone15ProMax = Device{
+		Name:              "iPhone 15 Pro Max",
+		Width:             430,
+		Height:            739,
+		ScreenWidth:       430,
+		ScreenHeight:      932,
+		DeviceScaleFactor: 3,
+		Mobile:            true,
+		Touch:             true,
+		UserAgent:         safariIPhone,
+	}
+	Pixel7 = Device{
+		Name:              "Pixel 7",
+		Width:             412,
+		Height:            839,
+		ScreenWidth:       412,
+		ScreenHeight:      915,
+		DeviceScaleFactor: 2.625,
+		Mobile:            true,
+		Touch:             true,
+		UserAgent:         chromeAndroidMobile,
+		UserAgentMetadata: chromeAndroid("Pixel 7", "14.0.0", true),
+	}
+	Pixel8 = Device{
+		Name:              "Pixel 8",
+		Width:             412,
+		Height:            839,
+		ScreenWidth:       412,
+		ScreenHeight:      915,
+		DeviceScaleFactor: 2.625,
+		Mobile:            true,
+		Touch:             true,
+		UserAgent:         chromeAndroidMobile,
+		UserAgentMetadata: chromeAndroid("Pixel 8", "14.0.0", true),
+	}
+	GalaxyS23 = Device{
+		Name:              "Galaxy S23",
+		Width:             360,
+		Height:            700,
+		ScreenWidth:       360,
+		ScreenHeight:      780,
+		DeviceScaleFactor: 3,
+		Mobile:            true,
+		Touch:             true,
+		UserAgent:         chromeAndroidMobile,
+		UserAgentMetadata: chromeAndroid("SM-S911B", "14.0.0", true),
+	}
+	IPadMini = Device{
+		Name:              "iPad Mini",
+		Width:             744,
+		Height:            1133,
+		DeviceScaleFactor: 2,
+		Mobile:            true,
+		Touch:             true,
+		UserAgent:         safariIPad,
+	}
+	IPadPro11 = Device{
+		Name:              "iPad Pro 11",
+		Width:             834,
+		Height:            1194,
+		DeviceScaleFactor: 2,
+		Mobile:            true,
+		Touch:             true,
+		UserAgent:         safariIPad,
+	}
+	GalaxyTabS9 = Device{
+		Name:              "Galaxy Tab S9",
+		Width:             800,
+		Height:            1280,
+		DeviceScaleFactor: 2,
+		Mobile:            true,
+		Touch:             true,
+		UserAgent:         chromeAndroidTablet,
+		UserAgentMetadata: chromeAndroid("SM-X710", "14.0.0", false),
+	}
+)
@@ -0,0 +1,132 @@
+package control
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/retrozoid/control/device"
+	"github.com/retrozoid/control/protocol/emulation"
+)
+
+var ErrNotEmulating = errors.New("session doesn't emulate a device")
+
+type emulationState struct {
+	mutex  sync.Mutex
+	device *device.Device
+}
+
+// Emulate applies the viewport, the scale factor, touch support and the user agent of the device,
+// the device without user agent restores the one of the browser. The previous emulation is restored if it fails
+func (s *Session) Emulate(d device.Device) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	s.state.emulation.mutex.Lock()
+	defer s.state.emulation.mutex.Unlock()
+	if err := s.applyDevice(&d); err != nil {
+		return errors.Join(err, s.applyDevice(s.state.emulation.device))
+	}
+	s.state.emulation.device = &d
+	return nil
+}
+
+func (s *Session) MustEmulate(d device.Device) {
+	if err := s.Emulate(d); err != nil {
+		panic(err)
+	}
+}
+
+// Emulated returns the emulated device
+func (s *Session) Emulated() (device.Device, bool) {
+	s.state.emulation.mutex.Lock()
+	defer s.state.emulation.mutex.Unlock()
+	if s.state.emulation.device == nil {
+		return device.Device{}, false
+	}
+	return *s.state.emulation.device, true
+}
+
+// SetOrientation rotates the emulated device, the page gets resize and orientationchange events
+func (s *Session) SetOrientation(orientation device.Orientation) error {
+	s.state.emulation.mutex.Lock()
+	defer s.state.emulation.mutex.Unlock()
+	if s.state.emulation.device == nil {
+		return ErrNotEmulating
+	}
+	d := s.state.emulation.device.Rotate(orientation)
+	if err := d.Validate(); err != nil {
+		return err
+	}
+	if err := s.setDeviceMetrics(d); err != nil {
+		return err
+	}
+	s.state.emulation.device = &d
+	return nil
+}
+
+func (s *Session) MustSetOrientation(orientation device.Orientation) {
+	if err := s.SetOrientation(orientation); err != nil {
+		panic(err)
+	}
+}
+
+// ResetEmulation restores the viewport, touch support and the user agent of the browser
+func (s *Session) ResetEmulation() error {
+	s.state.emulation.mutex.Lock()
+	defer s.state.emulation.mutex.Unlock()
+	if err := s.applyDevice(nil); err != nil {
+		return err
+	}
+	s.state.emulation.device = nil
+	return nil
+}
+
+func (s *Session) MustResetEmulation() {
+	if err := s.ResetEmulation(); err != nil {
+		panic(err)
+	}
+}
+
+// applyDevice overrides the device metrics, touch support and the user agent, nil device clears the overrides
+func (s *Session) applyDevice(d *device.Device) error {
+	if d == nil {
+		if err := emulation.ClearDeviceMetricsOverride(s); err != nil {
+			return err
+		}
+		if err := emulation.SetTouchEmulationEnabled(s, emulation.SetTouchEmulationEnabledArgs{Enabled: false}); err != nil {
+			return err
+		}
+		// empty user agent removes the override
+		return emulation.SetUserAgentOverride(s, emulation.SetUserAgentOverrideArgs{})
+	}
+	if err := s.setDeviceMetrics(*d); err != nil {
+		return err
+	}
+	if err := emulation.SetTouchEmulationEnabled(s, emulation.SetTouchEmulationEnabledArgs{
+		Enabled:        d.Touch,
+		MaxTouchPoints: d.TouchPoints(),
+	}); err != nil {
+		return err
+	}
+	return emulation.SetUserAgentOverride(s, emulation.SetUserAgentOverrideArgs{
+		UserAgent:         d.UserAgent,
+		UserAgentMetadata: d.UserAgentMetadata,
+	})
+}
+
+func (s *Session) setDeviceMetrics(d device.Device) error {
+	var (
+		width, height             = d.Viewport()
+		screenWidth, screenHeight = d.Screen()
+		kind, angle               = d.ScreenOrientation()
+	)
+	return emulation.SetDeviceMetricsOverride(s, emulation.SetDeviceMetricsOverrideArgs{
+		Width:             width,
+		Height:            height,
+		DeviceScaleFactor: d.DeviceScaleFactor,
+		Mobile:            d.Mobile,
+		ScreenWidth:       screenWidth,
+		ScreenHeight:      screenHeight,
+		ScreenOrientation: &emulation.ScreenOrientation{Type: kind, Angle: angle},
+	})
+}
@@ -0,0 +1,68 @@
+package control
+
+import (
+	"testing"
+
+	"github.com/retrozoid/control/cdp"
+	"github.com/retrozoid/control/cdptest"
+	"github.com/retrozoid/control/device"
+)
+
+func lastCallArgs(t *testing.T, server *cdptest.Server, method string) map[string]any {
+	t.Helper()
+	calls := server.Calls(method)
+	if len(calls) == 0 {
+		t.Fatalf("%s isn't called", method)
+	}
+	var args map[string]any
+	if err := calls[len(calls)-1].Unmarshal(&args); err != nil {
+		t.Fatal(err)
+	}
+	return args
+}
+
+func TestEmulateWithoutUserAgentRemovesOverride(t *testing.T) {
+	server, session, _ := newTestSession(t)
+	if err := session.Emulate(device.Pixel7); err != nil {
+		t.Fatal(err)
+	}
+	custom := device.Pixel7
+	custom.Name, custom.UserAgent, custom.UserAgentMetadata = "custom", "", nil
+	if err := session.Emulate(custom); err != nil {
+		t.Fatal(err)
+	}
+	if args := lastCallArgs(t, server, "Emulation.setUserAgentOverride"); args["userAgent"] != "" {
+		t.Errorf("user agent of the previous device is kept: %v", args["userAgent"])
+	}
+}
+
+func TestEmulateFailureRestoresPreviousDevice(t *testing.T) {
+	server, session, _ := newTestSession(t)
+	if err := session.Emulate(device.IPhoneSE); err != nil {
+		t.Fatal(err)
+	}
+	server.Handle("Emulation.setUserAgentOverride", func(c cdptest.Call) (any, error) {
+		var args struct {
+			UserAgent string `json:"userAgent"`
+		}
+		if err := c.Unmarshal(&args); err != nil {
+			return nil, err
+		}
+		if args.UserAgent == device.Pixel7.UserAgent {
+			return nil, &cdp.Error{Code: -32000, Message: "override failed"}
+		}
+		return nil, nil
+	})
+	if err := session.Emulate(device.Pixel7); err == nil {
+		t.Fatal("failed emulation succeeded")
+	}
+	if d, ok := session.Emulated(); !ok || d.Name != device.IPhoneSE.Name {
+		t.Errorf("emulated %q, the previous device expected", d.Name)
+	}
+	if args := lastCallArgs(t, server, "Emulation.setDeviceMetricsOverride"); args["width"] != float64(device.IPhoneSE.Width) {
+		t.Errorf("metrics of the failed device are kept: %v", args)
+	}
+	if args := lastCallArgs(t, server, "Emulation.setUserAgentOverride"); args["userAgent"] != device.IPhoneSE.UserAgent {
+		t.Errorf("user agent isn't restored: %v", args["userAgent"])
+	}
+}
@@ -0,0 +1,307 @@
+// Package expect provides assertions which are retried until they pass or the timing is over
+package expect
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/retrozoid/control"
+	"github.com/retrozoid/control/retry"
+)
+
+var DefaultTiming retry.Timing = retry.DefaultTiming
+
+// AssertionError describes the failed assertion with the last observed value
+type AssertionError struct {
+	Subject  string
+	Matcher  string
+	Not      bool
+	Expected any
+	Received any
+	Err      error
+}
+
+func (e AssertionError) Error() string {
+	var b strings.Builder
+	matcher := e.Matcher
+	if e.Not {
+		matcher = "Not()." + matcher
+	}
+	fmt.Fprintf(&b, "expect(%s).%s failed\n", e.Subject, matcher)
+	if e.Expected != nil {
+		fmt.Fprintf(&b, "  expected: %#v\n", e.Expected)
+	}
+	if e.Err != nil {
+		fmt.Fprintf(&b, "  error:    %s\n", e.Err)
+	} else {
+		fmt.Fprintf(&b, "  received: %#v\n", e.Received)
+	}
+	return b.String()
+}
+
+func (e AssertionError) Unwrap() error {
+	return e.Err
+}
+
+var errMismatch = errors.New("mismatch")
+
+type base struct {
+	t       testing.TB
+	subject string
+	timing  retry.Timing
+	not     bool
+}
+
+// poll calls observe until it matches (or doesn't for Not) and reports the failure to testing.TB
+func (b base) poll(matcher string, expected any, observe func() (received any, matched bool, err error)) error {
+	if b.t != nil {
+		b.t.Helper()
+	}
+	var (
+		received any
+		lastErr  error
+	)
+	err := retry.Func(b.timing, func() error {
+		value, matched, err := observe()
+		if err != nil {
+			lastErr = err
+			return err
+		}
+		received, lastErr = value, nil
+		if matched != b.not {
+			return nil
+		}
+		return errMismatch
+	})
+	if err == nil {
+		return nil
+	}
+	failure := AssertionError{
+		Subject:  b.subject,
+		Matcher:  matcher,
+		Not:      b.not,
+		Expected: expected,
+		Received: received,
+		Err:      lastErr,
+	}
+	if b.t != nil {
+		b.t.Fatalf("%s", failure)
+	}
+	return failure
+}
+
+// Expectation asserts the state of the element, t can be nil then the failure is only returned
+type Expectation struct {
+	base
+	node  func() (*control.Node, error)
+	count func() (int, error)
+}
+
+func Node(t testing.TB, node *control.Node) Expectation {
+	return Expectation{
+		base: base{t: t, subject: fmt.Sprintf("%q", node.String()), timing: DefaultTiming},
+		node: func() (*control.Node, error) { return node, nil },
+		count: func() (int, error) {
+			if node.IsConnected() {
+				return 1, nil
+			}
+			return 0, nil
+		},
+	}
+}
+
+// Locator asserts the first element matched by the locator, it is resolved on every attempt
+func Locator(t testing.TB, locator control.Locator) Expectation {
+	return Expectation{
+		base: base{t: t, subject: fmt.Sprintf("%q", locator.String()), timing: DefaultTiming},
+		node: func() (*control.Node, error) {
+			nodes, err := locator.All().Unwrap()
+			if err != nil {
+				return nil, err
+			}
+			if len(nodes) == 0 {
+				return nil, control.NoSuchSelectorError(locator.String())
+			}
+			return nodes[0], nil
+		},
+		count: func() (int, error) {
+			return locator.Count().Unwrap()
+		},
+	}
+}
+
+// Selector asserts the element found by the selector in the frame or node
+func Selector(t testing.TB, scope interface{ Locator(string) control.Locator }, selector string) Expectation {
+	return Locator(t, scope.Locator(selector))
+}
+
+func (e Expectation) WithTiming(timing retry.Timing) Expectation {
+	e.timing = timing
+	return e
+}
+
+func (e Expectation) Not() Expectation {
+	e.not = !e.not
+	return e
+}
+
+func nodeValue[T any](e Expectation, getter func(*control.Node) (T, error)) func() (T, error) {
+	return func() (T, error) {
+		node, err := e.node()
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		return getter(node)
+	}
+}
+
+func (e Expectation) ToHaveText(expected string) error {
+	if e.t != nil {
+		e.t.Helper()
+	}
+	get := nodeValue(e, func(n *control.Node) (string, error) { return n.GetText().Unwrap() })
+	return e.poll("ToHaveText", expected, func() (any, bool, error) {
+		value, err := get()
+		return value, strings.TrimSpace(value) == expected, err
+	})
+}
+
+func (e Expectation) ToContainText(expected string) error {
+	if e.t != nil {
+		e.t.Helper()
+	}
+	get := nodeValue(e, func(n *control.Node) (string, error) { return n.GetText().Unwrap() })
+	return e.poll("ToContainText", expected, func() (any, bool, error) {
+		value, err := get()
+		return value, strings.Contains(value, expected), err
+	})
+}
+
+func (e Expectation) ToHaveAttribute(name, expected string) error {
+	if e.t != nil {
+		e.t.Helper()
+	}
+	get := nodeValue(e, func(n *control.Node) (string, error) { return n.GetAttribute(name).Unwrap() })
+	return e.poll(fmt.Sprintf("ToHaveAttribute(%q)", name), expected, func() (any, bool, error) {
+		value, err := get()
+		return value, value == expected, err
+	})
+}
+
+func (e Expectation) ToHaveClass(class string) error {
+	if e.t != nil {
+		e.t.Helper()
+	}
+	get := nodeValue(e, func(n *control.Node) (bool, error) { return n.HasClass(class).Unwrap() })
+	className := nodeValue(e, func(n *control.Node) (string, error) { return n.GetAttribute("class").Unwrap() })
+	return e.poll("ToHaveClass", class, func() (any, bool, error) {
+		value, err := get()
+		if err != nil {
+			return nil, false, err
+		}
+		received, _ := className()
+		return received, value, nil
+	})
+}
+
+func (e Expectation) ToBeVisible() error {
+	if e.t != nil {
+		e.t.Helper()
+	}
+	get := nodeValue(e, func(n *control.Node) (bool, error) { return n.CheckVisibility().Unwrap() })
+	return e.poll("ToBeVisible", nil, func() (any, bool, error) {
+		value, err := get()
+		if errors.As(err, new(control.NoSuchSelectorError)) {
+			return "no such element", false, nil
+		}
+		return visibility(value), value, err
+	})
+}
+
+func visibility(visible bool) string {
+	if visible {
+		return "visible"
+	}
+	return "hidden"
+}
+
+func (e Expectation) ToBeChecked() error {
+	if e.t != nil {
+		e.t.Helper()
+	}
+	get := nodeValue(e, func(n *control.Node) (bool, error) { return n.IsChecked().Unwrap() })
+	return e.poll("ToBeChecked", nil, func() (any, bool, error) {
+		value, err := get()
+		return value, value, err
+	})
+}
+
+func (e Expectation) ToBeEnabled() error {
+	if e.t != nil {
+		e.t.Helper()
+	}
+	get := nodeValue(e, func(n *control.Node) (bool, error) { return n.IsEnabled().Unwrap() })
+	return e.poll("ToBeEnabled", nil, func() (any, bool, error) {
+		value, err := get()
+		return value, value, err
+	})
+}
+
+func (e Expectation) ToHaveCount(expected int) error {
+	if e.t != nil {
+		e.t.Helper()
+	}
+	return e.poll("ToHaveCount", expected, func() (any, bool, error) {
+		value, err := e.count()
+		return value, value == expected, err
+	})
+}
+
+// SessionExpectation asserts the state of the page
+type SessionExpectation struct {
+	base
+	session *control.Session
+}
+
+func Session(t testing.TB, session *control.Session) SessionExpectation {
+	return SessionExpectation{
+		base:    base{t: t, subject: "session " + session.GetID(), timing: DefaultTiming},
+		session: session,
+	}
+}
+
+func (e SessionExpectation) WithTiming(timing retry.Timing) SessionExpectation {
+	e.timing = timing
+	return e
+}
+
+func (e SessionExpectation) Not() SessionExpectation {
+	e.not = !e.not
+	return e
+}
+
+func (e SessionExpectation) ToHaveURL(expected string) error {
+	if e.t != nil {
+		e.t.Helper()
+	}
+	return e.poll("ToHaveURL", expected, func() (any, bool, error) {
+		value, err := e.session.GetCurrentURL().Unwrap()
+		return value, value == expected, err
+	})
+}
+
+func (e SessionExpectation) ToHaveTitle(expected string) error {
+	if e.t != nil {
+		e.t.Helper()
+	}
+	return e.poll("ToHaveTitle", expected, func() (any, bool, error) {
+		value, err := e.session.Frame.Evaluate("document.title", true).Unwrap()
+		if err != nil {
+			return nil, false, err
+		}
+		return value, value == expected, nil
+	})
+}
@@ -0,0 +1,195 @@
+package har
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/retrozoid/control"
+	"github.com/retrozoid/control/cdptest"
+)
+
+func newTestSession(t *testing.T) (*cdptest.Server, *control.Session, context.Context) {
+	t.Helper()
+	server := cdptest.NewServer()
+	t.Cleanup(server.Close)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	conn, err := control.Connect(ctx, server.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	session, err := conn.NewPage(control.Blank)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, session, ctx
+}
+
+func emit(t *testing.T, server *cdptest.Server, s *control.Session, method string, params map[string]any) {
+	t.Helper()
+	if err := server.Emit(s.GetID(), method, params); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// record makes HAR of the requests to /text, /binary and the failed /fail
+func record(t *testing.T) *HAR {
+	server, session, _ := newTestSession(t)
+	server.Handle("Network.getResponseBody", func(c cdptest.Call) (any, error) {
+		var args struct {
+			RequestId string `json:"requestId"`
+		}
+		if err := c.Unmarshal(&args); err != nil {
+			return nil, err
+		}
+		if args.RequestId == "binary" {
+			// "hi" is padded to 4 characters
+			return map[string]any{"body": "aGk=", "base64Encoded": true}, nil
+		}
+		return map[string]any{"body": "plain text"}, nil
+	})
+	recorder := Record(session)
+	for _, id := range []string{"text", "binary", "fail"} {
+		emit(t, server, session, "Network.requestWillBeSent", map[string]any{
+			"requestId": id,
+			"request":   map[string]any{"url": "http://site/" + id + "?q=1", "method": "GET", "headers": map[string]any{}},
+			"timestamp": 1,
+			"wallTime":  1700000000,
+		})
+	}
+	for _, id := range []string{"text", "binary"} {
+		emit(t, server, session, "Network.responseReceived", map[string]any{
+			"requestId": id,
+			"timestamp": 2,
+			"response": map[string]any{
+				"url":      "http://site/" + id,
+				"status":   200,
+				"headers":  map[string]any{"Content-Type": "text/plain", "Content-Length": "999"},
+				"mimeType": "text/plain",
+				"protocol": "h2",
+			},
+		})
+		emit(t, server, session, "Network.loadingFinished", map[string]any{"requestId": id, "timestamp": 3, "encodedDataLength": 42})
+	}
+	emit(t, server, session, "Network.loadingFailed", map[string]any{"requestId": "fail", "timestamp": 3, "errorText": "net::ERR_FAILED"})
+	// events are delivered to the recorder before the response
+	if err := session.Call("Test.sync", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	return recorder.Stop()
+}
+
+func TestRecord(t *testing.T) {
+	value := record(t)
+	if len(value.Log.Entries) != 3 {
+		t.Fatalf("%d entries recorded", len(value.Log.Entries))
+	}
+	for _, test := range []struct {
+		url      string
+		status   int
+		text     string
+		encoding string
+		size     int
+	}{
+		{"http://site/text?q=1", 200, "plain text", "", 10},
+		{"http://site/binary?q=1", 200, "aGk=", "base64", 2},
+		{"http://site/fail?q=1", 0, "", "", 0},
+	} {
+		var entry *Entry
+		for _, e := range value.Log.Entries {
+			if e.Request.URL == test.url {
+				entry = e
+			}
+		}
+		if entry == nil {
+			t.Errorf("%s isn't recorded", test.url)
+			continue
+		}
+		content := entry.Response.Content
+		if entry.Response.Status != test.status || content.Text != test.text || content.Encoding != test.encoding || content.Size != test.size {
+			t.Errorf("%s: status %d, content %+v", test.url, entry.Response.Status, content)
+		}
+		if len(entry.Request.QueryString) != 1 || entry.Request.QueryString[0].Value != "1" {
+			t.Errorf("%s: query %v", test.url, entry.Request.QueryString)
+		}
+	}
+}
+
+// waitCall waits for the call of the method made for the paused request
+func waitCall(t *testing.T, ctx context.Context, server *cdptest.Server, method, requestID string) map[string]any {
+	t.Helper()
+	for {
+		for _, call := range server.Calls(method) {
+			var args map[string]any
+			if err := call.Unmarshal(&args); err != nil {
+				t.Fatal(err)
+			}
+			if args["requestId"] == requestID {
+				return args
+			}
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("%s of %s isn't called", method, requestID)
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestReplay(t *testing.T) {
+	var buf bytes.Buffer
+	if err := record(t).Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	value, err := Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		policy   NotFoundPolicy
+		notFound string
+	}{
+		{NotFoundContinue, "Fetch.continueRequest"},
+		{NotFoundAbort, "Fetch.failRequest"},
+	} {
+		server, session, ctx := newTestSession(t)
+		stop, err := Replay(session, value, test.policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, id := range []string{"text", "binary", "fail", "unknown"} {
+			emit(t, server, session, "Fetch.requestPaused", map[string]any{
+				"requestId":    id,
+				"request":      map[string]any{"url": "http://site/" + id + "?q=1", "method": "GET", "headers": map[string]any{}},
+				"frameId":      "frame",
+				"resourceType": "Document",
+			})
+		}
+
+		for id, body := range map[string]string{"text": "cGxhaW4gdGV4dA==", "binary": "aGk="} {
+			args := waitCall(t, ctx, server, "Fetch.fulfillRequest", id)
+			if args["responseCode"] != float64(200) || args["body"] != body {
+				t.Errorf("%s is fulfilled with %v", id, args)
+			}
+			// the recorded body is decoded already
+			for _, header := range args["responseHeaders"].([]any) {
+				if name := header.(map[string]any)["name"]; name != "Content-Type" {
+					t.Errorf("%s is fulfilled with header %s", id, name)
+				}
+			}
+		}
+		if args := waitCall(t, ctx, server, "Fetch.failRequest", "fail"); args["errorReason"] != "Failed" {
+			t.Errorf("failed request is replayed with %v", args)
+		}
+		args := waitCall(t, ctx, server, test.notFound, "unknown")
+		if test.policy == NotFoundAbort && args["errorReason"] != "InternetDisconnected" {
+			t.Errorf("unknown request is aborted with %v", args)
+		}
+		if err = stop(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
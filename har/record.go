@@ -0,0 +1,345 @@
+package har
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/retrozoid/control"
+	"github.com/retrozoid/control/cdp"
+	"github.com/retrozoid/control/protocol/network"
+)
+
+type pending struct {
+	entry     *Entry
+	timestamp network.MonotonicTime
+	timing    *network.ResourceTiming
+}
+
+// Recorder collects the requests of a session from Network domain events, Network domain is enabled by the session itself.
+// Post data longer than control.MaxPostDataSize and response bodies are requested after the request is finished
+type Recorder struct {
+	session *control.Session
+	mutex   sync.Mutex
+	pending map[network.RequestId]*pending
+	entries []*Entry
+	fetches sync.WaitGroup
+	cancel  func()
+	done    chan struct{}
+}
+
+func Record(session *control.Session) *Recorder {
+	// entries are incomplete without any of the events, the loop doesn't wait for the user code
+	subscription := session.SubscribeWithOptions(cdp.SubscribeOptions{Methods: []string{"Network.*"}, Overflow: cdp.OverflowBlock})
+	channel, cancel := subscription.C(), subscription.Cancel
+	r := &Recorder{
+		session: session,
+		pending: map[network.RequestId]*pending{},
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go r.run(channel)
+	return r
+}
+
+// Stop stops recording and returns the entries of the finished requests
+func (r *Recorder) Stop() *HAR {
+	r.cancel()
+	<-r.done
+	r.fetches.Wait()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	entries := []*Entry{}
+	for _, entry := range r.entries {
+		if entry.Response != nil {
+			entries = append(entries, entry)
+		}
+	}
+	return &HAR{
+		Log: &Log{
+			Version: Version,
+			Creator: &Creator{Name: CreatorName},
+			Entries: entries,
+		},
+	}
+}
+
+func (r *Recorder) run(channel chan cdp.Message) {
+	defer close(r.done)
+	for message := range channel {
+		switch message.Method {
+
+		case "Network.requestWillBeSent":
+			var value network.RequestWillBeSent
+			if unmarshal(message, &value) {
+				r.requestWillBeSent(value)
+			}
+
+		case "Network.responseReceived":
+			var value network.ResponseReceived
+			if unmarshal(message, &value) {
+				r.responseReceived(value)
+			}
+
+		case "Network.loadingFinished":
+			var value network.LoadingFinished
+			if unmarshal(message, &value) {
+				r.loadingFinished(value)
+			}
+
+		case "Network.loadingFailed":
+			var value network.LoadingFailed
+			if unmarshal(message, &value) {
+				r.loadingFailed(value)
+			}
+		}
+	}
+}
+
+func (r *Recorder) requestWillBeSent(value network.RequestWillBeSent) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if redirected, ok := r.pending[value.RequestId]; ok && value.RedirectResponse != nil {
+		redirected.entry.Response = convertResponse(value.RedirectResponse)
+		redirected.entry.Response.RedirectURL = value.Request.Url
+		redirected.timing = value.RedirectResponse.Timing
+		finish(redirected, value.Timestamp)
+	}
+	entry := &Entry{
+		StartedDateTime: time.UnixMicro(int64(float64(value.WallTime) * 1e6)).UTC().Format(time.RFC3339Nano),
+		Request:         convertRequest(value.Request),
+		Cache:           &Cache{},
+	}
+	r.entries = append(r.entries, entry)
+	r.pending[value.RequestId] = &pending{
+		entry:     entry,
+		timestamp: value.Timestamp,
+	}
+	if value.Request.HasPostData && value.Request.PostData == "" {
+		r.fetches.Add(1)
+		go r.fetchPostData(value.RequestId, entry)
+	}
+}
+
+func (r *Recorder) responseReceived(value network.ResponseReceived) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if p, ok := r.pending[value.RequestId]; ok {
+		p.entry.Response = convertResponse(value.Response)
+		p.entry.Request.HTTPVersion = p.entry.Response.HTTPVersion
+		p.entry.ServerIPAddress = value.Response.RemoteIPAddress
+		p.timing = value.Response.Timing
+	}
+}
+
+func (r *Recorder) loadingFinished(value network.LoadingFinished) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	p, ok := r.pending[value.RequestId]
+	if !ok {
+		return
+	}
+	delete(r.pending, value.RequestId)
+	if p.entry.Response == nil {
+		return
+	}
+	p.entry.Response.BodySize = int(value.EncodedDataLength)
+	finish(p, value.Timestamp)
+	r.fetches.Add(1)
+	go r.fetchResponseBody(value.RequestId, p.entry)
+}
+
+func (r *Recorder) loadingFailed(value network.LoadingFailed) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	p, ok := r.pending[value.RequestId]
+	if !ok {
+		return
+	}
+	delete(r.pending, value.RequestId)
+	if p.entry.Response == nil {
+		p.entry.Response = &Response{
+			StatusText:  value.ErrorText,
+			Cookies:     []*Cookie{},
+			Headers:     []*NameValue{},
+			Content:     &Content{},
+			HeadersSize: -1,
+			BodySize:    -1,
+			Comment:     value.ErrorText,
+		}
+	}
+	finish(p, value.Timestamp)
+}
+
+func (r *Recorder) fetchPostData(id network.RequestId, entry *Entry) {
+	defer r.fetches.Done()
+	val, err := network.GetRequestPostData(r.session, network.GetRequestPostDataArgs{RequestId: id})
+	if err != nil {
+		r.session.Log("har: can't get request post data", "requestId", id, "err", err)
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	entry.Request.PostData = &PostData{
+		MimeType: headerValue(entry.Request.Headers, "Content-Type"),
+		Text:     val.PostData,
+	}
+	entry.Request.BodySize = len(val.PostData)
+}
+
+func (r *Recorder) fetchResponseBody(id network.RequestId, entry *Entry) {
+	defer r.fetches.Done()
+	val, err := network.GetResponseBody(r.session, network.GetResponseBodyArgs{RequestId: id})
+	if err != nil {
+		// there is no body for redirects, 204 responses and evicted resources
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	entry.Response.Content.Text = val.Body
+	entry.Response.Content.Size = len(val.Body)
+	if val.Base64Encoded {
+		entry.Response.Content.Encoding = "base64"
+		// DecodedLen is the upper bound counting the padding as well
+		if body, err := base64.StdEncoding.DecodeString(val.Body); err == nil {
+			entry.Response.Content.Size = len(body)
+		}
+	}
+}
+
+func unmarshal(message cdp.Message, value any) bool {
+	return json.Unmarshal(message.Params, value) == nil
+}
+
+func finish(p *pending, finished network.MonotonicTime) {
+	p.entry.Timings, p.entry.Time = timings(p.timing, p.timestamp, finished)
+}
+
+func timings(t *network.ResourceTiming, started, finished network.MonotonicTime) (*Timings, float64) {
+	total := float64(finished-started) * 1000
+	if t == nil {
+		return &Timings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1, Receive: total}, total
+	}
+	value := &Timings{
+		Blocked: -1,
+		DNS:     phase(t.DnsStart, t.DnsEnd),
+		Connect: phase(t.ConnectStart, t.ConnectEnd),
+		SSL:     phase(t.SslStart, t.SslEnd),
+		Send:    t.SendEnd - t.SendStart,
+		Wait:    t.ReceiveHeadersEnd - t.SendEnd,
+		Receive: (float64(finished)-t.RequestTime)*1000 - t.ReceiveHeadersEnd,
+	}
+	for _, start := range []float64{t.DnsStart, t.ConnectStart, t.SendStart} {
+		if start >= 0 {
+			value.Blocked = start
+			break
+		}
+	}
+	if value.Receive < 0 {
+		value.Receive = 0
+	}
+	total = value.Send + value.Wait + value.Receive
+	for _, v := range []float64{value.Blocked, value.DNS, value.Connect} {
+		if v > 0 {
+			total += v
+		}
+	}
+	return value, total
+}
+
+func phase(start, end float64) float64 {
+	if start < 0 {
+		return -1
+	}
+	return end - start
+}
+
+func convertRequest(value *network.Request) *Request {
+	request := &Request{
+		Method:      value.Method,
+		URL:         value.Url,
+		Cookies:     []*Cookie{},
+		Headers:     convertHeaders(value.Headers),
+		QueryString: []*NameValue{},
+		HeadersSize: -1,
+	}
+	if u, err := url.Parse(value.Url); err == nil {
+		for name, values := range u.Query() {
+			for _, v := range values {
+				request.QueryString = append(request.QueryString, &NameValue{Name: name, Value: v})
+			}
+		}
+		sortNameValues(request.QueryString)
+	}
+	if value.PostData != "" {
+		request.PostData = &PostData{
+			MimeType: headerValue(request.Headers, "Content-Type"),
+			Text:     value.PostData,
+		}
+		request.BodySize = len(value.PostData)
+	}
+	return request
+}
+
+func convertResponse(value *network.Response) *Response {
+	return &Response{
+		Status:      value.Status,
+		StatusText:  value.StatusText,
+		HTTPVersion: httpVersion(value.Protocol),
+		Cookies:     []*Cookie{},
+		Headers:     convertHeaders(value.Headers),
+		Content:     &Content{MimeType: value.MimeType},
+		RedirectURL: headerValue(convertHeaders(value.Headers), "Location"),
+		HeadersSize: -1,
+		BodySize:    -1,
+	}
+}
+
+func httpVersion(protocol string) string {
+	switch protocol {
+	case "h2":
+		return "HTTP/2.0"
+	case "h3":
+		return "HTTP/3.0"
+	case "":
+		return ""
+	default:
+		return strings.ToUpper(protocol)
+	}
+}
+
+// convertHeaders splits multiline header values (e.g. Set-Cookie) into separate entries
+func convertHeaders(value *network.Headers) []*NameValue {
+	headers := []*NameValue{}
+	if value == nil {
+		return headers
+	}
+	if m, ok := (*value).(map[string]any); ok {
+		for name, v := range m {
+			for _, line := range strings.Split(fmt.Sprint(v), "\n") {
+				headers = append(headers, &NameValue{Name: name, Value: line})
+			}
+		}
+	}
+	sortNameValues(headers)
+	return headers
+}
+
+func sortNameValues(values []*NameValue) {
+	sort.SliceStable(values, func(i, j int) bool { return values[i].Name < values[j].Name })
+}
+
+func headerValue(headers []*NameValue, name string) string {
+	for _, header := range headers {
+		if strings.EqualFold(header.Name, name) {
+			return header.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,119 @@
+package har
+
+import (
+	"encoding/base64"
+	"strings"
+	"sync"
+
+	"github.com/retrozoid/control"
+	"github.com/retrozoid/control/protocol/network"
+)
+
+// NotFoundPolicy defines what to do with the requests which are absent in the replayed HAR
+type NotFoundPolicy int
+
+const (
+	// NotFoundContinue sends the request to the network
+	NotFoundContinue NotFoundPolicy = iota
+	// NotFoundAbort fails the request as the network is unreachable
+	NotFoundAbort
+)
+
+// skipped response headers, the recorded body is already decoded
+var replaySkipHeaders = map[string]bool{
+	"content-encoding":  true,
+	"content-length":    true,
+	"transfer-encoding": true,
+}
+
+type player struct {
+	session *control.Session
+	policy  NotFoundPolicy
+	mutex   sync.Mutex
+	entries map[string][]*Entry
+}
+
+// Replay fulfills the session requests from the HAR entries matched by method and URL.
+// Entries with the same method and URL are served in the recorded order, the last one is served repeatedly
+func Replay(session *control.Session, value *HAR, policy NotFoundPolicy) (stop func() error, err error) {
+	p := &player{
+		session: session,
+		policy:  policy,
+		entries: map[string][]*Entry{},
+	}
+	for _, entry := range value.Log.Entries {
+		key := replayKey(entry.Request.Method, entry.Request.URL)
+		p.entries[key] = append(p.entries[key], entry)
+	}
+	return session.Route(control.Glob("**"), p.handle)
+}
+
+func replayKey(method, url string) string {
+	return method + " " + url
+}
+
+func (p *player) lookup(method, url string) *Entry {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	key := replayKey(method, url)
+	entries := p.entries[key]
+	if len(entries) == 0 {
+		return nil
+	}
+	if len(entries) > 1 {
+		p.entries[key] = entries[1:]
+	}
+	return entries[0]
+}
+
+func (p *player) handle(request *control.InterceptedRequest) {
+	var err error
+	entry := p.lookup(request.Method(), request.URL())
+	switch {
+	case entry == nil && p.policy == NotFoundAbort:
+		err = request.Fail(network.ErrorReason("InternetDisconnected"))
+	case entry == nil:
+		err = request.Continue(control.ContinueOverrides{})
+	case entry.Response.Status == 0:
+		err = request.Fail(network.ErrorReason("Failed"))
+	default:
+		var response control.FulfillResponse
+		if response, err = fulfillResponse(entry.Response); err == nil {
+			err = request.Fulfill(response)
+		}
+	}
+	if err != nil {
+		p.session.Log("har: can't replay request", "url", request.URL(), "err", err)
+	}
+}
+
+func fulfillResponse(value *Response) (control.FulfillResponse, error) {
+	response := control.FulfillResponse{
+		Status:  value.Status,
+		Phrase:  value.StatusText,
+		Headers: map[string]string{},
+	}
+	for _, header := range value.Headers {
+		if replaySkipHeaders[strings.ToLower(header.Name)] || strings.HasPrefix(header.Name, ":") {
+			continue
+		}
+		if v, ok := response.Headers[header.Name]; ok {
+			response.Headers[header.Name] = v + "\n" + header.Value
+		} else {
+			response.Headers[header.Name] = header.Value
+		}
+	}
+	if value.Content == nil {
+		return response, nil
+	}
+	if value.Content.Encoding == "base64" {
+		body, err := base64.StdEncoding.DecodeString(value.Content.Text)
+		if err != nil {
+			return response, err
+		}
+		response.Body = body
+		return response, nil
+	}
+	response.Body = []byte(value.Content.Text)
+	return response, nil
+}
@@ -0,0 +1,190 @@
+package control
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"github.com/retrozoid/control/cdp"
+	"github.com/retrozoid/control/protocol"
+)
+
+// ListenerError is a handler error or a recovered handler panic
+type ListenerError struct {
+	Method string
+	Err    error
+}
+
+func (e ListenerError) Error() string {
+	return fmt.Sprintf("listener of %s: %s", e.Method, e.Err)
+}
+
+func (e ListenerError) Unwrap() error {
+	return e.Err
+}
+
+type listenerErrors struct {
+	mutex   sync.Mutex
+	handler func(ListenerError)
+}
+
+// OnListenerError replaces the handler of listener errors, they are logged by default
+func (s *Session) OnListenerError(handler func(ListenerError)) {
+	s.state.listenerErrors.mutex.Lock()
+	defer s.state.listenerErrors.mutex.Unlock()
+	s.state.listenerErrors.handler = handler
+}
+
+func (s *Session) reportListenerError(err ListenerError) {
+	s.state.listenerErrors.mutex.Lock()
+	handler := s.state.listenerErrors.handler
+	s.state.listenerErrors.mutex.Unlock()
+	if handler == nil {
+		s.Log("listener failed", "err", err)
+		return
+	}
+	handler(err)
+}
+
+// ListenBufferSize is the number of events buffered for the listener without its own size
+var ListenBufferSize = 1024
+
+// ListenOptions are the event buffer of the listener and the policy of its overflow
+type ListenOptions struct {
+	// Size is the number of buffered events, ListenBufferSize if it's zero
+	Size int
+	// Overflow drops the oldest events by default, dropped events are reported to OnListenerError.
+	// cdp.OverflowBlock delays all events and responses of the transport until the handler catches up
+	Overflow cdp.OverflowPolicy
+}
+
+func (s *Session) subscribeListener(method string, opts ListenOptions) *cdp.Subscription {
+	if opts.Size <= 0 {
+		opts.Size = ListenBufferSize
+	}
+	return s.SubscribeWithOptions(cdp.SubscribeOptions{
+		Methods:  []string{method},
+		Size:     opts.Size,
+		Overflow: opts.Overflow,
+	})
+}
+
+// reportDropped reports events dropped since the last report
+func (s *Session) reportDropped(method string, subscription *cdp.Subscription, reported *uint64) {
+	if dropped := subscription.Dropped(); dropped > *reported {
+		err := fmt.Errorf("%w: %d events dropped", cdp.ErrSubscriptionOverflow, dropped-*reported)
+		*reported = dropped
+		s.reportListenerError(ListenerError{Method: method, Err: err})
+	}
+}
+
+// Listen calls the handler with params of every event of the method, one by one in order of arrival.
+// Handler error and panic are reported to OnListenerError, the listener keeps going.
+// Listener is stopped by unsubscribe or when the session is done
+func (s *Session) Listen(method string, handler func(params []byte) error) (unsubscribe func()) {
+	return s.ListenWithOptions(method, ListenOptions{}, handler)
+}
+
+// ListenWithOptions is Listen with own event buffer, see ListenOptions
+func (s *Session) ListenWithOptions(method string, opts ListenOptions, handler func(params []byte) error) (unsubscribe func()) {
+	subscription := s.subscribeListener(method, opts)
+	go func() {
+		defer subscription.Cancel()
+		var reported uint64
+		for {
+			select {
+			case <-s.context.Done():
+				return
+			case message, ok := <-subscription.C():
+				if !ok {
+					return
+				}
+				s.reportDropped(method, subscription, &reported)
+				if err := callListener(handler, message.Params); err != nil {
+					s.reportListenerError(ListenerError{Method: message.Method, Err: err})
+				}
+			}
+		}
+	}()
+	return subscription.Cancel
+}
+
+func callListener(handler func([]byte) error, params []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v\n%s", r, debug.Stack())
+		}
+	}()
+	return handler(params)
+}
+
+// On calls the handler with every event of the method decoded to T, see Session.Listen
+func On[T any](s *Session, method string, handler func(T) error) (unsubscribe func()) {
+	return protocol.On(s, method, handler)
+}
+
+// OnWithOptions is On with own event buffer, see ListenOptions
+func OnWithOptions[T any](s *Session, method string, opts ListenOptions, handler func(T) error) (unsubscribe func()) {
+	return s.ListenWithOptions(method, opts, func(params []byte) error {
+		var value T
+		if err := unmarshalParams(params, &value); err != nil {
+			return err
+		}
+		return handler(value)
+	})
+}
+
+// Events returns the channel of events of the method decoded to T, it's closed by cancel or when the session is done.
+// Events are buffered, undecodable and dropped ones are reported to OnListenerError
+func Events[T any](s *Session, method string) (events <-chan T, cancel func()) {
+	return EventsWithOptions[T](s, method, ListenOptions{})
+}
+
+// EventsWithOptions is Events with own event buffer, see ListenOptions
+func EventsWithOptions[T any](s *Session, method string, opts ListenOptions) (events <-chan T, cancel func()) {
+	var (
+		subscription = s.subscribeListener(method, opts)
+		channel      = make(chan T)
+		once         sync.Once
+		done         = make(chan struct{})
+	)
+	go func() {
+		defer close(channel)
+		defer subscription.Cancel()
+		var reported uint64
+		for {
+			select {
+			case <-s.context.Done():
+				return
+			case <-done:
+				return
+			case message, ok := <-subscription.C():
+				if !ok {
+					return
+				}
+				s.reportDropped(method, subscription, &reported)
+				var value T
+				if err := unmarshalParams(message.Params, &value); err != nil {
+					s.reportListenerError(ListenerError{Method: message.Method, Err: err})
+					continue
+				}
+				select {
+				case channel <- value:
+				case <-done:
+					return
+				case <-s.context.Done():
+					return
+				}
+			}
+		}
+	}()
+	return channel, func() { once.Do(func() { close(done) }) }
+}
+
+func unmarshalParams(params []byte, value any) error {
+	if len(params) == 0 {
+		return nil
+	}
+	return json.Unmarshal(params, value)
+}
@@ -0,0 +1,62 @@
+package control
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/retrozoid/control/cdp"
+)
+
+func TestListenSlowHandlerDropsOldest(t *testing.T) {
+	server, session, ctx := newTestSession(t)
+	reported := make(chan ListenerError, 10)
+	session.OnListenerError(func(err ListenerError) { reported <- err })
+
+	var (
+		started = make(chan struct{})
+		release = make(chan struct{})
+		seen    = make(chan int, 10)
+	)
+	unsubscribe := OnWithOptions(session, "Test.event", ListenOptions{Size: 2}, func(value struct{ N int }) error {
+		if value.N == 0 {
+			close(started)
+			<-release
+		}
+		seen <- value.N
+		return nil
+	})
+	defer unsubscribe()
+
+	for n := 0; n < 6; n++ {
+		if err := server.Emit(session.GetID(), "Test.event", map[string]int{"n": n}); err != nil {
+			t.Fatal(err)
+		}
+		if n == 0 {
+			<-started
+		}
+	}
+	// the stuck handler doesn't delay responses of the transport
+	if err := session.Call("Test.sync", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	close(release)
+
+	for _, want := range []int{0, 4, 5} {
+		select {
+		case n := <-seen:
+			if n != want {
+				t.Errorf("event %d handled, %d expected", n, want)
+			}
+		case <-ctx.Done():
+			t.Fatalf("event %d isn't handled", want)
+		}
+	}
+	select {
+	case err := <-reported:
+		if !errors.Is(err, cdp.ErrSubscriptionOverflow) || err.Method != "Test.event" {
+			t.Errorf("unexpected listener error %v", err)
+		}
+	case <-ctx.Done():
+		t.Fatal("dropped events aren't reported")
+	}
+}
@@ -0,0 +1,408 @@
+package control
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/retrozoid/control/retry"
+)
+
+type locatorStepKind int
+
+const (
+	locatorQuery locatorStepKind = iota
+	locatorNth
+	locatorHasText
+)
+
+type locatorStep struct {
+	kind     locatorStepKind
+	selector string
+	nth      int
+	text     string
+}
+
+func (s locatorStep) String() string {
+	switch s.kind {
+	case locatorNth:
+		return fmt.Sprintf("nth=%d", s.nth)
+	case locatorHasText:
+		return fmt.Sprintf("has-text=%q", s.text)
+	default:
+		return s.selector
+	}
+}
+
+// Locator is a lazy selector, it is resolved to a Node on every action
+// and the action is retried until the node is actionable or the timing is over
+type Locator struct {
+	scope  Queryable
+	steps  []locatorStep
+	timing retry.Timing
+}
+
+func (f Frame) Locator(selector string) Locator {
+	return newLocator(&f, selector)
+}
+
+func (e Node) Locator(selector string) Locator {
+	return newLocator(&e, selector)
+}
+
+func newLocator(scope Queryable, selector string) Locator {
+	return Locator{
+		scope:  scope,
+		steps:  []locatorStep{{kind: locatorQuery, selector: selector}},
+		timing: retry.DefaultTiming,
+	}
+}
+
+func (l Locator) String() string {
+	values := make([]string, len(l.steps))
+	for n, step := range l.steps {
+		values[n] = step.String()
+	}
+	return strings.Join(values, " >> ")
+}
+
+func (l Locator) with(step locatorStep) Locator {
+	l.steps = append(slices.Clip(l.steps), step)
+	return l
+}
+
+// WithTiming returns the locator with another retry timing
+func (l Locator) WithTiming(timing retry.Timing) Locator {
+	l.timing = timing
+	return l
+}
+
+// WithTimeout returns the locator with another timeout and the same delay between attempts
+func (l Locator) WithTimeout(timeout time.Duration) Locator {
+	return l.WithTiming(retry.Static{Timeout: timeout, Delay: 100 * time.Millisecond})
+}
+
+// Locator finds the selector inside of every element matched by this locator
+func (l Locator) Locator(selector string) Locator {
+	return l.with(locatorStep{kind: locatorQuery, selector: selector})
+}
+
+// Nth picks the element by index, negative index counts from the end
+func (l Locator) Nth(index int) Locator {
+	return l.with(locatorStep{kind: locatorNth, nth: index})
+}
+
+func (l Locator) First() Locator {
+	return l.Nth(0)
+}
+
+func (l Locator) Last() Locator {
+	return l.Nth(-1)
+}
+
+// Filter keeps the elements which text contains the given one
+func (l Locator) Filter(hasText string) Locator {
+	return l.with(locatorStep{kind: locatorHasText, text: hasText})
+}
+
+func (l Locator) resolveAll() (NodeList, error) {
+	var (
+		scopes = []Queryable{l.scope}
+		nodes  NodeList
+	)
+	for _, step := range l.steps {
+		switch step.kind {
+
+		case locatorQuery:
+			nodes = NodeList{}
+			for _, scope := range scopes {
+				value, err := scope.QueryAll(step.selector).Unwrap()
+				if err != nil {
+					var noSuchSelector NoSuchSelectorError
+					if errors.As(err, &noSuchSelector) {
+						continue
+					}
+					return nil, err
+				}
+				nodes = append(nodes, value...)
+			}
+
+		case locatorNth:
+			index := step.nth
+			if index < 0 {
+				index += len(nodes)
+			}
+			if index < 0 || index >= len(nodes) {
+				nodes = NodeList{}
+			} else {
+				nodes = NodeList{nodes[index]}
+			}
+
+		case locatorHasText:
+			filtered := NodeList{}
+			for _, node := range nodes {
+				text, err := node.GetText().Unwrap()
+				if err != nil {
+					return nil, err
+				}
+				if strings.Contains(text, step.text) {
+					filtered = append(filtered, node)
+				}
+			}
+			nodes = filtered
+		}
+
+		scopes = make([]Queryable, len(nodes))
+		for n, node := range nodes {
+			scopes[n] = node
+		}
+	}
+	for _, node := range nodes {
+		node.requestedSelector = l.String()
+	}
+	return nodes, nil
+}
+
+func (l Locator) resolve() (*Node, error) {
+	nodes, err := l.resolveAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, NoSuchSelectorError(l.String())
+	}
+	return nodes[0], nil
+}
+
+type actionability int
+
+const (
+	attached actionability = 1 << iota
+	visible
+	enabled
+)
+
+func (l Locator) check(node *Node, state actionability) error {
+	if state&attached != 0 && !node.IsConnected() {
+		return NoSuchSelectorError(l.String())
+	}
+	if state&visible != 0 {
+		value, err := node.CheckVisibility().Unwrap()
+		if err != nil {
+			return err
+		}
+		if !value {
+			return NodeInvisibleError(l.String())
+		}
+	}
+	if state&enabled != 0 {
+		value, err := node.IsEnabled().Unwrap()
+		if err != nil {
+			return err
+		}
+		if !value {
+			return NodeDisabledError(l.String())
+		}
+	}
+	return nil
+}
+
+// do resolves the locator and calls the action until it succeeds or the timing is over,
+// the stability of the element is checked by the actions which need a clickable point
+func (l Locator) do(state actionability, action func(*Node) error) error {
+	ctx, cancel := l.scope.OwnerFrame().session.withTimeout(0)
+	defer cancel()
+	return retry.FuncContext(ctx, l.timing, func() error {
+		node, err := l.resolve()
+		if err != nil {
+			return err
+		}
+		if err = l.check(node, state); err != nil {
+			return err
+		}
+		return action(node)
+	})
+}
+
+func locatorValue[T any](l Locator, state actionability, getter func(*Node) Optional[T]) Optional[T] {
+	var value T
+	err := l.do(state, func(n *Node) (err error) {
+		value, err = getter(n).Unwrap()
+		return err
+	})
+	if err != nil {
+		return Optional[T]{err: err}
+	}
+	return Optional[T]{value: value}
+}
+
+// Node waits for the element to be attached and returns it
+func (l Locator) Node() Optional[*Node] {
+	return locatorValue(l, attached, func(n *Node) Optional[*Node] { return Optional[*Node]{value: n} })
+}
+
+func (l Locator) MustNode() *Node {
+	return l.Node().MustGetValue()
+}
+
+// All returns the currently matched elements without waiting
+func (l Locator) All() Optional[NodeList] {
+	return optional[NodeList](l.resolveAll())
+}
+
+func (l Locator) MustAll() NodeList {
+	return l.All().MustGetValue()
+}
+
+// Count returns the number of currently matched elements without waiting
+func (l Locator) Count() Optional[int] {
+	nodes, err := l.resolveAll()
+	if err != nil {
+		return Optional[int]{err: err}
+	}
+	return Optional[int]{value: len(nodes)}
+}
+
+func (l Locator) MustCount() int {
+	return l.Count().MustGetValue()
+}
+
+// WaitFor waits for the element to be attached and visible
+func (l Locator) WaitFor() error {
+	return l.do(attached|visible, func(*Node) error { return nil })
+}
+
+func (l Locator) MustWaitFor() {
+	panicIfError(l.WaitFor())
+}
+
+func (l Locator) Click() error {
+	return l.do(attached|visible|enabled, func(n *Node) error { return n.Click() })
+}
+
+func (l Locator) MustClick() {
+	panicIfError(l.Click())
+}
+
+func (l Locator) Down() error {
+	return l.do(attached|visible|enabled, func(n *Node) error { return n.Down() })
+}
+
+func (l Locator) MustDown() {
+	panicIfError(l.Down())
+}
+
+func (l Locator) Hover() error {
+	return l.do(attached|visible, func(n *Node) error { return n.Hover() })
+}
+
+func (l Locator) MustHover() {
+	panicIfError(l.Hover())
+}
+
+func (l Locator) Focus() error {
+	return l.do(attached, func(n *Node) error { return n.Focus() })
+}
+
+func (l Locator) MustFocus() {
+	panicIfError(l.Focus())
+}
+
+func (l Locator) Blur() error {
+	return l.do(attached, func(n *Node) error { return n.Blur() })
+}
+
+func (l Locator) MustBlur() {
+	panicIfError(l.Blur())
+}
+
+func (l Locator) ScrollIntoView() error {
+	return l.do(attached, func(n *Node) error { return n.ScrollIntoView() })
+}
+
+func (l Locator) MustScrollIntoView() {
+	panicIfError(l.ScrollIntoView())
+}
+
+func (l Locator) SetText(value string) error {
+	return l.do(attached|visible|enabled, func(n *Node) error { return n.SetText(value) })
+}
+
+func (l Locator) MustSetText(value string) {
+	panicIfError(l.SetText(value))
+}
+
+func (l Locator) InsertText(value string) error {
+	return l.do(attached|visible|enabled, func(n *Node) error { return n.InsertText(value) })
+}
+
+func (l Locator) MustInsertText(value string) {
+	panicIfError(l.InsertText(value))
+}
+
+func (l Locator) Upload(files ...string) error {
+	return l.do(attached|enabled, func(n *Node) error { return n.Upload(files...) })
+}
+
+func (l Locator) MustUpload(files ...string) {
+	panicIfError(l.Upload(files...))
+}
+
+func (l Locator) SelectByValues(values ...string) error {
+	return l.do(attached|visible|enabled, func(n *Node) error { return n.SelectByValues(values...) })
+}
+
+func (l Locator) MustSelectByValues(values ...string) {
+	panicIfError(l.SelectByValues(values...))
+}
+
+func (l Locator) SetCheckbox(check bool) error {
+	return l.do(attached|visible|enabled, func(n *Node) error { return n.SetCheckbox(check) })
+}
+
+func (l Locator) MustSetCheckbox(check bool) {
+	panicIfError(l.SetCheckbox(check))
+}
+
+func (l Locator) GetText() Optional[string] {
+	return locatorValue(l, attached, (*Node).GetText)
+}
+
+func (l Locator) MustGetText() string {
+	return l.GetText().MustGetValue()
+}
+
+func (l Locator) GetAttribute(attr string) Optional[string] {
+	return locatorValue(l, attached, func(n *Node) Optional[string] { return n.GetAttribute(attr) })
+}
+
+func (l Locator) MustGetAttribute(attr string) string {
+	return l.GetAttribute(attr).MustGetValue()
+}
+
+func (l Locator) IsChecked() Optional[bool] {
+	return locatorValue(l, attached, (*Node).IsChecked)
+}
+
+func (l Locator) MustIsChecked() bool {
+	return l.IsChecked().MustGetValue()
+}
+
+// IsVisible checks the visibility of the element without waiting, missing element is invisible
+func (l Locator) IsVisible() Optional[bool] {
+	node, err := l.resolve()
+	if err != nil {
+		var noSuchSelector NoSuchSelectorError
+		if errors.As(err, &noSuchSelector) {
+			return Optional[bool]{value: false}
+		}
+		return Optional[bool]{err: err}
+	}
+	return node.CheckVisibility()
+}
+
+func (l Locator) MustIsVisible() bool {
+	return l.IsVisible().MustGetValue()
+}
@@ -5,11 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"log/slog"
-	"net/http"
 
 	"github.com/retrozoid/control/cdp"
 	"github.com/retrozoid/control/chrome"
-	"github.com/retrozoid/control/protocol/target"
 )
 
 func Take(args ...string) (session *Session, cancel func(), err error) {
@@ -17,36 +15,57 @@ func Take(args ...string) (session *Session, cancel func(), err error) {
 }
 
 func TakeWithContext(ctx context.Context, logger *slog.Logger, chromeArgs ...string) (session *Session, cancel func(), err error) {
-	browser, err := chrome.Launch(ctx, chromeArgs...)
+	return TakeWithOptions(ctx, logger, chrome.LaunchOptions{Flags: chromeArgs})
+}
+
+// TakeWithPipe launches the browser with --remote-debugging-pipe instead of the debugging port
+func TakeWithPipe(ctx context.Context, logger *slog.Logger, chromeArgs ...string) (session *Session, cancel func(), err error) {
+	return TakeWithOptions(ctx, logger, chrome.LaunchOptions{Flags: chromeArgs, Pipe: true})
+}
+
+func TakeWithOptions(ctx context.Context, logger *slog.Logger, opts chrome.LaunchOptions) (session *Session, cancel func(), err error) {
+	browser, conn, err := launch(ctx, logger, opts)
 	if err != nil {
-		return nil, nil, errors.Join(err, errors.New("chrome launch failed"))
+		return nil, nil, err
 	}
-	tab, err := browser.NewTab(http.DefaultClient, "")
+	session, err = conn.NewPage("")
 	if err != nil {
-		return nil, nil, errors.Join(err, errors.New("failed to open a new tab"))
+		// the transport is likely broken already, the browser is killed anyway
+		_ = conn.Close()
+		return nil, nil, errors.Join(err, errors.New("failed to create a new session"), browser.Kill())
 	}
-	transport, err := cdp.DefaultDial(ctx, browser.WebSocketUrl, logger)
-	if err != nil {
-		return nil, nil, errors.Join(err, errors.New("websocket dial failed"))
+	teardown := func() {
+		closeBrowser(browser, conn)
 	}
-	session, err = NewSession(transport, target.TargetID(tab.ID))
+	return session, teardown, nil
+}
+
+func launch(ctx context.Context, logger *slog.Logger, opts chrome.LaunchOptions) (chrome.Chrome, *Connection, error) {
+	browser, err := chrome.LaunchWithOptions(ctx, opts)
 	if err != nil {
-		return nil, nil, errors.Join(err, errors.New("failed to create a new session"))
+		return browser, nil, errors.Join(err, errors.New("chrome launch failed"))
 	}
-	teardown := func() {
-		if err := transport.Close(); err != nil {
-			transport.Log(slog.LevelError, "can't close transport", "err", err.Error())
-		}
-		if err = browser.WaitCloseGracefully(); err != nil {
-			transport.Log(slog.LevelError, "can't close browser gracefully", "err", err.Error())
-		}
+	var transport *cdp.Transport
+	if opts.Pipe {
+		transport = cdp.NewTransport(ctx, cdp.NewPipeConn(browser.Pipe()), logger)
+	} else if transport, err = cdp.DefaultDial(ctx, browser.WebSocketUrl, logger); err != nil {
+		return browser, nil, errors.Join(err, errors.New("websocket dial failed"), browser.Kill())
+	}
+	return browser, NewConnection(transport), nil
+}
+
+func closeBrowser(browser chrome.Chrome, conn *Connection) {
+	if err := conn.transport.Close(); err != nil {
+		conn.transport.Log(slog.LevelError, "can't close transport", "err", err.Error())
+	}
+	if err := browser.WaitCloseGracefully(); err != nil {
+		conn.transport.Log(slog.LevelError, "can't close browser gracefully", "err", err.Error())
 	}
-	return session, teardown, nil
 }
 
 func Subscribe[T any](s *Session, method string, filter func(T) bool) cdp.Future[T] {
 	var (
-		channel, cancel = s.Subscribe()
+		channel, cancel = s.subscribe(method)
 	)
 	callback := func(resolve func(T), reject func(error)) {
 		for value := range channel {
@@ -0,0 +1,538 @@
+package control
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/retrozoid/control/cdp"
+	"github.com/retrozoid/control/protocol/common"
+	"github.com/retrozoid/control/protocol/network"
+)
+
+// inflightRequests counts the network requests of a session that are not finished yet
+type inflightRequests struct {
+	mutex    sync.Mutex
+	requests map[network.RequestId]struct{}
+	changed  chan struct{}
+}
+
+func newInflightRequests() *inflightRequests {
+	return &inflightRequests{
+		requests: map[network.RequestId]struct{}{},
+		changed:  make(chan struct{}),
+	}
+}
+
+func (r *inflightRequests) add(id network.RequestId) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, ok := r.requests[id]; !ok {
+		r.requests[id] = struct{}{}
+		r.notify()
+	}
+}
+
+func (r *inflightRequests) remove(id network.RequestId) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, ok := r.requests[id]; ok {
+		delete(r.requests, id)
+		r.notify()
+	}
+}
+
+// notify wakes up all waiters, must be called under the lock
+func (r *inflightRequests) notify() {
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+func (r *inflightRequests) state() (int, <-chan struct{}) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return len(r.requests), r.changed
+}
+
+func headersToMap(value *network.Headers) map[string]string {
+	headers := map[string]string{}
+	if value == nil {
+		return headers
+	}
+	if m, ok := (*value).(map[string]any); ok {
+		for name, val := range m {
+			headers[name] = fmt.Sprint(val)
+		}
+	}
+	return headers
+}
+
+// WaitNetworkIdle waits until the number of inflight requests stays at or below maxInflight for the idleFor duration
+func (s *Session) WaitNetworkIdle(idleFor time.Duration, maxInflight int, timeout time.Duration) error {
+	var (
+		deadline = time.NewTimer(timeout)
+		idle     *time.Timer
+		idleC    <-chan time.Time
+	)
+	defer deadline.Stop()
+	// the deadline reports its own error
+	ctx, cancel := s.withTimeout(0)
+	defer cancel()
+	defer func() {
+		if idle != nil {
+			idle.Stop()
+		}
+	}()
+	for {
+		count, changed := s.inflight.state()
+		switch {
+		case count > maxInflight && idle != nil:
+			idle.Stop()
+			idle, idleC = nil, nil
+		case count <= maxInflight && idle == nil:
+			idle = time.NewTimer(idleFor)
+			idleC = idle.C
+		}
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case <-deadline.C:
+			return ErrNetworkIdleReachedTimeout
+		case <-idleC:
+			return nil
+		case <-changed:
+		}
+	}
+}
+
+func (s *Session) MustWaitNetworkIdle(idleFor time.Duration, maxInflight int, timeout time.Duration) {
+	if err := s.WaitNetworkIdle(idleFor, maxInflight, timeout); err != nil {
+		panic(err)
+	}
+}
+
+// Request is a network request of the session correlated from Network domain events
+type Request struct {
+	network        *Network
+	ID             network.RequestId
+	LoaderID       network.LoaderId
+	FrameID        common.FrameId
+	URL            string
+	Method         string
+	Headers        map[string]string
+	PostData       string
+	ResourceType   network.ResourceType
+	Initiator      *network.Initiator
+	WallTime       time.Time
+	Timestamp      network.MonotonicTime
+	RedirectedFrom *Request
+	redirectedTo   *Request
+	response       *Response
+	failure        string
+	fromCache      bool
+	endTimestamp   network.MonotonicTime
+	finished       chan struct{}
+}
+
+func (r *Request) Response() *Response {
+	r.network.mutex.Lock()
+	defer r.network.mutex.Unlock()
+	return r.response
+}
+
+func (r *Request) RedirectedTo() *Request {
+	r.network.mutex.Lock()
+	defer r.network.mutex.Unlock()
+	return r.redirectedTo
+}
+
+// RedirectChain returns the requests from the original one to this one
+func (r *Request) RedirectChain() []*Request {
+	var chain []*Request
+	for value := r; value != nil; value = value.RedirectedFrom {
+		chain = append([]*Request{value}, chain...)
+	}
+	return chain
+}
+
+// Failure returns error text of the failed request
+func (r *Request) Failure() string {
+	r.network.mutex.Lock()
+	defer r.network.mutex.Unlock()
+	return r.failure
+}
+
+func (r *Request) FromCache() bool {
+	r.network.mutex.Lock()
+	defer r.network.mutex.Unlock()
+	return r.fromCache
+}
+
+// Finished is closed when the request is finished, failed or redirected
+func (r *Request) Finished() <-chan struct{} {
+	return r.finished
+}
+
+// Duration returns time from the request start to the end of its loading, zero if it's not finished yet
+func (r *Request) Duration() time.Duration {
+	r.network.mutex.Lock()
+	defer r.network.mutex.Unlock()
+	if r.endTimestamp == 0 {
+		return 0
+	}
+	return time.Duration(float64(r.endTimestamp-r.Timestamp) * float64(time.Second))
+}
+
+func (r *Request) finish(timestamp network.MonotonicTime) {
+	select {
+	case <-r.finished:
+	default:
+		r.endTimestamp = timestamp
+		close(r.finished)
+	}
+}
+
+type Response struct {
+	request           *Request
+	URL               string
+	Status            int
+	StatusText        string
+	Headers           map[string]string
+	MimeType          string
+	Protocol          string
+	RemoteIPAddress   string
+	RemotePort        int
+	FromDiskCache     bool
+	FromServiceWorker bool
+	Timing            *network.ResourceTiming
+	Timestamp         network.MonotonicTime
+	body              Optional[[]byte]
+	bodyOnce          sync.Once
+}
+
+func (r *Response) Request() *Request {
+	return r.request
+}
+
+// Body waits for the request to finish and fetches the response body once
+func (r *Response) Body() Optional[[]byte] {
+	r.bodyOnce.Do(func() {
+		r.body = optional[[]byte](r.fetchBody())
+	})
+	return r.body
+}
+
+func (r *Response) MustBody() []byte {
+	return r.Body().MustGetValue()
+}
+
+func (r *Response) fetchBody() ([]byte, error) {
+	session := r.request.network.session
+	ctx, cancel := session.withTimeout(session.Timeouts().orDefault(0))
+	defer cancel()
+	select {
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	case <-r.request.finished:
+	}
+	// browser returns the body of the last response of the redirect chain for any of its hops
+	if r.request.RedirectedTo() != nil {
+		return nil, ErrRedirectBody
+	}
+	if failure := r.request.Failure(); failure != "" {
+		if failure == ErrRequestForgotten.Error() {
+			return nil, ErrRequestForgotten
+		}
+		return nil, errors.New(failure)
+	}
+	val, err := network.GetResponseBody(session, network.GetResponseBodyArgs{RequestId: r.request.ID})
+	if err != nil {
+		return nil, err
+	}
+	if val.Base64Encoded {
+		return base64.StdEncoding.DecodeString(val.Body)
+	}
+	return []byte(val.Body), nil
+}
+
+func newResponse(request *Request, value *network.Response, timestamp network.MonotonicTime) *Response {
+	return &Response{
+		request:           request,
+		URL:               value.Url,
+		Status:            value.Status,
+		StatusText:        value.StatusText,
+		Headers:           headersToMap(value.Headers),
+		MimeType:          value.MimeType,
+		Protocol:          value.Protocol,
+		RemoteIPAddress:   value.RemoteIPAddress,
+		RemotePort:        value.RemotePort,
+		FromDiskCache:     value.FromDiskCache,
+		FromServiceWorker: value.FromServiceWorker,
+		Timing:            value.Timing,
+		Timestamp:         timestamp,
+	}
+}
+
+// MaxNetworkRequests is the number of requests kept by Network, the oldest ones are forgotten beyond it
+var MaxNetworkRequests = 10000
+
+var (
+	ErrRedirectBody     = errors.New("redirect response has no body")
+	ErrRequestForgotten = errors.New("request is forgotten by the network recorder")
+)
+
+// Network keeps the requests of the session since the first call of Session.Network() until Stop
+type Network struct {
+	session         *Session
+	mutex           sync.Mutex
+	seq             uint64
+	requests        []*Request
+	byID            map[network.RequestId]*Request
+	requestWaiters  map[uint64]*waiter[*Request]
+	responseWaiters map[uint64]*waiter[*Response]
+	cancel          func()
+	stopped         bool
+}
+
+func (s *Session) Network() *Network {
+	s.state.networkOnce.Do(func() {
+		s.state.network = &Network{
+			session:         s,
+			byID:            map[network.RequestId]*Request{},
+			requestWaiters:  map[uint64]*waiter[*Request]{},
+			responseWaiters: map[uint64]*waiter[*Response]{},
+		}
+		var channel chan cdp.Message
+		channel, s.state.network.cancel = s.subscribeAll("Network.*")
+		go s.state.network.handle(channel)
+	})
+	return s.state.network
+}
+
+// Stop stops recording, the recorded requests are kept and unfinished ones fail with ErrRequestForgotten.
+// Session.Network returns the stopped recorder afterwards
+func (n *Network) Stop() {
+	n.cancel()
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.stopped = true
+	for _, request := range n.byID {
+		n.forget(request)
+	}
+}
+
+// Requests returns recorded requests in order of their start
+func (n *Network) Requests() []*Request {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return append([]*Request{}, n.requests...)
+}
+
+// Clear forgets recorded requests, unfinished ones fail with ErrRequestForgotten
+func (n *Network) Clear() {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	for _, request := range n.requests {
+		n.forget(request)
+	}
+	n.requests = nil
+}
+
+// forget removes unfinished request from the index and finishes it, must be called under the lock
+func (n *Network) forget(request *Request) {
+	if n.byID[request.ID] == request {
+		delete(n.byID, request.ID)
+	}
+	select {
+	case <-request.finished:
+	default:
+		request.failure = ErrRequestForgotten.Error()
+		request.finish(request.Timestamp)
+	}
+}
+
+// waiter queues the events for the predicate of WaitForRequest or WaitForResponse,
+// the predicate runs in the goroutine of the promise and never stalls the recorder
+type waiter[T any] struct {
+	mutex  sync.Mutex
+	queue  []T
+	notify chan struct{}
+}
+
+func (w *waiter[T]) push(value T) {
+	w.mutex.Lock()
+	w.queue = append(w.queue, value)
+	w.mutex.Unlock()
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *waiter[T]) pop() []T {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	queue := w.queue
+	w.queue = nil
+	return queue
+}
+
+func waitFor[T any](n *Network, waiters map[uint64]*waiter[T], predicate func(T) bool) cdp.Future[T] {
+	var (
+		w    = &waiter[T]{notify: make(chan struct{}, 1)}
+		done = make(chan struct{})
+	)
+	n.mutex.Lock()
+	n.seq++
+	id := n.seq
+	waiters[id] = w
+	n.mutex.Unlock()
+	finally := func() {
+		n.mutex.Lock()
+		delete(waiters, id)
+		n.mutex.Unlock()
+		close(done)
+	}
+	return cdp.NewPromise(func(resolve func(T), reject func(error)) {
+		for {
+			select {
+			case <-w.notify:
+				for _, value := range w.pop() {
+					if predicate(value) {
+						resolve(value)
+						return
+					}
+				}
+			case <-done:
+				return
+			}
+		}
+	}, finally)
+}
+
+// WaitForRequest resolves with the first request matching the predicate, the predicate is free to wait for the response body
+func (n *Network) WaitForRequest(predicate func(*Request) bool) cdp.Future[*Request] {
+	return waitFor(n, n.requestWaiters, predicate)
+}
+
+// WaitForResponse resolves with the first response matching the predicate, the predicate is free to wait for its body
+func (n *Network) WaitForResponse(predicate func(*Response) bool) cdp.Future[*Response] {
+	return waitFor(n, n.responseWaiters, predicate)
+}
+
+func (n *Network) handle(channel chan cdp.Message) {
+	for message := range channel {
+		switch message.Method {
+
+		case "Network.requestWillBeSent":
+			requestWillBeSent := mustUnmarshal[network.RequestWillBeSent](message)
+			request, redirected := n.requestWillBeSent(requestWillBeSent)
+			if request == nil {
+				continue
+			}
+			if redirected != nil {
+				n.emitResponse(redirected.response)
+			}
+			n.emitRequest(request)
+
+		case "Network.responseReceived":
+			responseReceived := mustUnmarshal[network.ResponseReceived](message)
+			n.mutex.Lock()
+			request, ok := n.byID[responseReceived.RequestId]
+			if ok {
+				request.response = newResponse(request, responseReceived.Response, responseReceived.Timestamp)
+			}
+			n.mutex.Unlock()
+			if ok {
+				n.emitResponse(request.response)
+			}
+
+		case "Network.requestServedFromCache":
+			requestServedFromCache := mustUnmarshal[network.RequestServedFromCache](message)
+			n.mutex.Lock()
+			if request, ok := n.byID[requestServedFromCache.RequestId]; ok {
+				request.fromCache = true
+			}
+			n.mutex.Unlock()
+
+		case "Network.loadingFinished":
+			loadingFinished := mustUnmarshal[network.LoadingFinished](message)
+			n.mutex.Lock()
+			if request, ok := n.byID[loadingFinished.RequestId]; ok {
+				delete(n.byID, loadingFinished.RequestId)
+				request.finish(loadingFinished.Timestamp)
+			}
+			n.mutex.Unlock()
+
+		case "Network.loadingFailed":
+			loadingFailed := mustUnmarshal[network.LoadingFailed](message)
+			n.mutex.Lock()
+			if request, ok := n.byID[loadingFailed.RequestId]; ok {
+				delete(n.byID, loadingFailed.RequestId)
+				request.failure = loadingFailed.ErrorText
+				request.finish(loadingFailed.Timestamp)
+			}
+			n.mutex.Unlock()
+		}
+	}
+}
+
+// requestWillBeSent returns nil request if the recorder is stopped
+func (n *Network) requestWillBeSent(value network.RequestWillBeSent) (request, redirected *Request) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	if n.stopped {
+		return nil, nil
+	}
+	request = &Request{
+		network:      n,
+		ID:           value.RequestId,
+		LoaderID:     value.LoaderId,
+		FrameID:      value.FrameId,
+		URL:          value.Request.Url,
+		Method:       value.Request.Method,
+		Headers:      headersToMap(value.Request.Headers),
+		PostData:     value.Request.PostData,
+		ResourceType: value.Type,
+		Initiator:    value.Initiator,
+		WallTime:     time.UnixMicro(int64(float64(value.WallTime) * 1e6)),
+		Timestamp:    value.Timestamp,
+		finished:     make(chan struct{}),
+	}
+	if prev, ok := n.byID[value.RequestId]; ok && value.RedirectResponse != nil {
+		prev.response = newResponse(prev, value.RedirectResponse, value.Timestamp)
+		prev.redirectedTo = request
+		prev.finish(value.Timestamp)
+		request.RedirectedFrom = prev
+		redirected = prev
+	}
+	n.byID[value.RequestId] = request
+	n.requests = append(n.requests, request)
+	if excess := len(n.requests) - MaxNetworkRequests; excess > 0 && MaxNetworkRequests > 0 {
+		for _, oldest := range n.requests[:excess] {
+			n.forget(oldest)
+		}
+		clear(n.requests[:excess])
+		n.requests = n.requests[excess:]
+	}
+	return request, redirected
+}
+
+func (n *Network) emitRequest(request *Request) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	for _, w := range n.requestWaiters {
+		w.push(request)
+	}
+}
+
+func (n *Network) emitResponse(response *Response) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	for _, w := range n.responseWaiters {
+		w.push(response)
+	}
+}
@@ -0,0 +1,131 @@
+package control
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/retrozoid/control/cdptest"
+)
+
+func emitRequest(t *testing.T, server *cdptest.Server, s *Session, id, url string, redirectStatus int) {
+	t.Helper()
+	params := map[string]any{
+		"requestId": id,
+		"request":   map[string]any{"url": url, "method": "GET"},
+		"timestamp": 1,
+	}
+	if redirectStatus != 0 {
+		params["redirectResponse"] = map[string]any{"url": "http://redirect/", "status": redirectStatus}
+	}
+	if err := server.Emit(s.GetID(), "Network.requestWillBeSent", params); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNetworkRedirectBody(t *testing.T) {
+	server, session, ctx := newTestSession(t)
+	n := session.Network()
+	redirected := n.WaitForRequest(func(r *Request) bool { return r.RedirectedFrom != nil })
+	emitRequest(t, server, session, "1", "http://redirect/", 0)
+	emitRequest(t, server, session, "1", "http://target/", 302)
+	request, err := redirected.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hop := request.RedirectedFrom
+	if hop.Response() == nil || hop.Response().Status != 302 {
+		t.Fatalf("redirect response isn't recorded: %+v", hop.Response())
+	}
+	if err = hop.Response().Body().Err(); !errors.Is(err, ErrRedirectBody) {
+		t.Errorf("body of redirect hop: %v", err)
+	}
+}
+
+func TestNetworkForgetsRequests(t *testing.T) {
+	server, session, ctx := newTestSession(t)
+	defer func(size int) { MaxNetworkRequests = size }(MaxNetworkRequests)
+	MaxNetworkRequests = 2
+	n := session.Network()
+
+	third := n.WaitForRequest(func(r *Request) bool { return r.ID == "3" })
+	for _, id := range []string{"1", "2", "3"} {
+		emitRequest(t, server, session, id, "http://"+id+"/", 0)
+	}
+	if _, err := third.Get(ctx); err != nil {
+		t.Fatal(err)
+	}
+	requests := n.Requests()
+	if len(requests) != 2 || requests[0].ID != "2" || requests[1].ID != "3" {
+		t.Fatalf("unexpected requests %v", requests)
+	}
+
+	n.Clear()
+	if len(n.Requests()) != 0 {
+		t.Error("requests are kept by Clear")
+	}
+	for _, request := range requests {
+		select {
+		case <-request.Finished():
+		default:
+			t.Fatalf("forgotten request %s isn't finished", request.ID)
+		}
+		if request.Failure() != ErrRequestForgotten.Error() {
+			t.Errorf("forgotten request %s failure %q", request.ID, request.Failure())
+		}
+	}
+}
+
+func TestNetworkStop(t *testing.T) {
+	server, session, ctx := newTestSession(t)
+	n := session.Network()
+	first := n.WaitForRequest(func(r *Request) bool { return r.ID == "1" })
+	emitRequest(t, server, session, "1", "http://1/", 0)
+	request, err := first.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n.Stop()
+	select {
+	case <-request.Finished():
+	default:
+		t.Fatal("unfinished request isn't finished by Stop")
+	}
+	emitRequest(t, server, session, "2", "http://2/", 0)
+	if err = session.Call("Test.sync", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if requests := n.Requests(); len(requests) != 1 {
+		t.Errorf("stopped recorder keeps recording: %v", requests)
+	}
+	if session.Network() != n {
+		t.Error("Session.Network replaced the stopped recorder")
+	}
+}
+
+func TestNetworkPredicateWaitsForBody(t *testing.T) {
+	server, session, ctx := newTestSession(t)
+	server.Handle("Network.getResponseBody", cdptest.Result(map[string]any{"body": "aGVsbG8=", "base64Encoded": true}))
+	n := session.Network()
+	hello := n.WaitForResponse(func(r *Response) bool { return string(r.Body().MustGetValue()) == "hello" })
+	emitRequest(t, server, session, "1", "http://hello/", 0)
+	// the predicate is blocked in Body until the recorder takes loadingFinished
+	for _, event := range []struct {
+		method string
+		params map[string]any
+	}{
+		{"Network.responseReceived", map[string]any{"requestId": "1", "timestamp": 2, "response": map[string]any{"url": "http://hello/", "status": 200}}},
+		{"Network.loadingFinished", map[string]any{"requestId": "1", "timestamp": 3}},
+	} {
+		if err := server.Emit(session.GetID(), event.method, event.params); err != nil {
+			t.Fatal(err)
+		}
+	}
+	response, err := hello.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Request().ID != "1" {
+		t.Errorf("unexpected response of %s", response.Request().ID)
+	}
+}
@@ -1,7 +1,6 @@
 package control
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -18,6 +17,8 @@ type (
 	NodeNonFocusableError string
 	NodeInvisibleError    string
 	NodeUnstableError     string
+	NodeDisabledError     string
+	NoShadowRootError     string
 	NoSuchSelectorError   string
 )
 
@@ -33,10 +34,18 @@ func (n NodeUnstableError) Error() string {
 	return fmt.Sprintf("selector `%s` is not stable", string(n))
 }
 
+func (n NodeDisabledError) Error() string {
+	return fmt.Sprintf("selector `%s` is disabled", string(n))
+}
+
 func (n NodeNonFocusableError) Error() string {
 	return fmt.Sprintf("selector `%s` is not focusable", string(n))
 }
 
+func (n NoShadowRootError) Error() string {
+	return fmt.Sprintf("selector `%s` has no open shadow root", string(n))
+}
+
 func (s NoSuchSelectorError) Error() string {
 	return fmt.Sprintf("no such selector found: `%s`", string(s))
 }
@@ -217,6 +226,29 @@ func (e Node) eval(function string, args ...any) (any, error) {
 	return e.frame.CallFunctionOn(e, function, true, args...)
 }
 
+// evalNodeList calls the function returning an array or NodeList of elements
+func (e Node) evalNodeList(function string, args ...any) (NodeList, error) {
+	value, err := runtime.CallFunctionOn(e.frame, runtime.CallFunctionOnArgs{
+		FunctionDeclaration: function,
+		ObjectId:            e.GetRemoteObjectID(),
+		AwaitPromise:        true,
+		Arguments:           e.frame.toCallArgument(args...),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = toDOMException(value.ExceptionDetails); err != nil {
+		return nil, err
+	}
+	if value.Result == nil || value.Result.ObjectId == "" {
+		return NodeList{}, nil
+	}
+	defer func() {
+		_ = runtime.ReleaseObject(e, runtime.ReleaseObjectArgs{ObjectId: value.Result.ObjectId})
+	}()
+	return e.frame.requestNodeList(value.Result.ObjectId)
+}
+
 func (e Node) asyncEval(function string, args ...any) (RemoteObject, error) {
 	value, err := e.frame.CallFunctionOn(e, function, false, args...)
 	if err != nil {
@@ -233,13 +265,18 @@ func (e Node) dispatchEvents(events ...any) error {
 	return err
 }
 
+// String returns the selector the node was found by
+func (e Node) String() string {
+	return e.requestedSelector
+}
+
 func (e Node) Log(msg string, args ...any) {
 	args = append(args, "self", e.requestedSelector)
 	e.frame.Log(msg, args...)
 }
 
 func (e Node) HasClass(class string) Optional[bool] {
-	return optional[bool](e.eval(`function(c){return this.classList.contains(c)}`))
+	return optional[bool](e.eval(`function(c){return this.classList.contains(c)}`, class))
 }
 
 func (e Node) MustHasClass(class string) bool {
@@ -262,40 +299,101 @@ func (e Node) MustAsyncCallFunctionOn(function string, args ...any) RemoteObject
 	return e.AsyncCallFunctionOn(function, args...).MustGetValue()
 }
 
-func (e Node) Query(cssSelector string) Optional[*Node] {
-	return optional[*Node](e.query(cssSelector))
+func (e Node) Query(selector string) Optional[*Node] {
+	return optional[*Node](e.query(selector))
 }
 
-func (e Node) MustQuery(cssSelector string) *Node {
-	return e.Query(cssSelector).MustGetValue()
+func (e Node) MustQuery(selector string) *Node {
+	return e.Query(selector).MustGetValue()
 }
 
-func (e Node) query(cssSelector string) (*Node, error) {
-	value, err := e.eval(`function(s){return this.querySelector(s)}`, cssSelector)
+func (e Node) query(selector string) (*Node, error) {
+	parts, err := parseSelector(selector)
 	if err != nil {
 		return nil, err
 	}
-	if value == nil {
-		return nil, NoSuchSelectorError(cssSelector)
+	var node *Node
+	if isPlainCSS(parts) {
+		value, err := e.eval(`function(s){return this.querySelector(s)}`, selector)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			return nil, NoSuchSelectorError(selector)
+		}
+		node = value.(*Node)
+	} else {
+		nodes, err := e.queryAll(selector)
+		if err != nil {
+			return nil, err
+		}
+		node = nodes[0]
 	}
-	node := value.(*Node)
-	if e.frame.session.highlightEnabled {
+	if e.frame.session.state.highlightEnabled {
 		_ = node.Highlight()
 	}
-	node.requestedSelector = cssSelector
+	node.requestedSelector = selector
 	return node, nil
 }
 
-func (e Node) QueryAll(cssSelector string) Optional[NodeList] {
-	value, err := e.eval(`function(s){return this.querySelectorAll(s)}`, cssSelector)
+func (e Node) QueryAll(selector string) Optional[NodeList] {
+	parts, err := parseSelector(selector)
+	if err != nil {
+		return Optional[NodeList]{err: err}
+	}
+	if !isPlainCSS(parts) {
+		return optional[NodeList](e.queryAll(selector))
+	}
+	value, err := e.eval(`function(s){return this.querySelectorAll(s)}`, selector)
 	if err == nil && value == nil {
-		err = NoSuchSelectorError(cssSelector)
+		err = NoSuchSelectorError(selector)
 	}
 	return optional[NodeList](value, err)
 }
 
-func (e Node) MustQueryAll(cssSelector string) NodeList {
-	return e.QueryAll(cssSelector).MustGetValue()
+func (e Node) MustQueryAll(selector string) NodeList {
+	return e.QueryAll(selector).MustGetValue()
+}
+
+// ShadowRoot returns the open shadow root of the element to query inside of it
+func (e Node) ShadowRoot() Optional[*Node] {
+	value, err := e.eval(`function(){return this.shadowRoot}`)
+	if err != nil {
+		return Optional[*Node]{err: err}
+	}
+	if value == nil {
+		return Optional[*Node]{err: NoShadowRootError(e.requestedSelector)}
+	}
+	node := value.(*Node)
+	// it's not a selector, no selector matches the shadow root itself
+	node.requestedSelector = e.requestedSelector + " (shadow root)"
+	return Optional[*Node]{value: node}
+}
+
+func (e Node) MustShadowRoot() *Node {
+	return e.ShadowRoot().MustGetValue()
+}
+
+// shadowRoots returns the node itself and all open shadow roots inside of it recursively
+func (e Node) shadowRoots() (NodeList, error) {
+	roots, err := e.evalNodeList(`function(){
+		const r = [],
+			w = e => {
+				const t = (e.ownerDocument || e).createTreeWalker(e, NodeFilter.SHOW_ELEMENT)
+				for (let n = t.currentNode; n; n = t.nextNode()) {
+					if (n.shadowRoot) {
+						r.push(n.shadowRoot)
+						w(n.shadowRoot)
+					}
+				}
+			}
+		w(this)
+		return r
+	}`)
+	if err != nil {
+		return nil, err
+	}
+	return append(NodeList{&e}, roots...), nil
 }
 
 func (e Node) ContentFrame() Optional[*Frame] {
@@ -408,6 +506,14 @@ func (e Node) CheckVisibility() Optional[bool] {
 	return optional[bool](value, err)
 }
 
+func (e Node) IsEnabled() Optional[bool] {
+	return optional[bool](e.eval(`function(){return !(this.disabled||this.closest('fieldset:disabled')||this.getAttribute('aria-disabled')==='true')}`))
+}
+
+func (e Node) MustIsEnabled() bool {
+	return e.IsEnabled().MustGetValue()
+}
+
 func (e Node) Upload(files ...string) error {
 	return dom.SetFileInputFiles(e, dom.SetFileInputFilesArgs{
 		ObjectId: e.GetRemoteObjectID(),
@@ -432,16 +538,9 @@ func (e Node) Click() (err error) {
 	defer future.Cancel()
 	_, err = e.eval(`function(func) {
 		let a = window[func],
-			d = (b) => {
-				for (let d = b; d; d = d.parentNode) {
-					if (d === this) {
-						return !0
-					}
-				}
-				return !1
-			},
+			d = (b) => b.composedPath().includes(this),
 			f = (b) => {
-				if (b.isTrusted && d(b.target)) {
+				if (b.isTrusted && d(b)) {
 					a('')
 				} else {
 					b.stopImmediatePropagation()
@@ -457,7 +556,7 @@ func (e Node) Click() (err error) {
 	if err = e.frame.session.Click(point); err != nil {
 		return err
 	}
-	ctx, cancel := context.WithTimeout(e.frame.session.context, e.frame.session.timeout)
+	ctx, cancel := e.frame.session.withTimeout(e.frame.session.Timeouts().action())
 	defer cancel()
 	call, err := future.Get(ctx)
 	if err != nil {
@@ -486,16 +585,9 @@ func (e Node) Down() (err error) {
 
 	_, err = e.eval(`function(func) {
 		let a = window[func],
-			d = (b) => {
-				for (let d = b; d; d = d.parentNode) {
-					if (d === this) {
-						return !0
-					}
-				}
-				return !1
-			},
+			d = (b) => b.composedPath().includes(this),
 			f = (b) => {
-				if (b.isTrusted && d(b.target)) {
+				if (b.isTrusted && d(b)) {
 					a('')
 				} else {
 					b.stopImmediatePropagation()
@@ -508,7 +600,7 @@ func (e Node) Down() (err error) {
 	if err = e.frame.session.MouseDown(point); err != nil {
 		return err
 	}
-	ctx, cancel := context.WithTimeout(e.frame.session.context, e.frame.session.timeout)
+	ctx, cancel := e.frame.session.withTimeout(e.frame.session.Timeouts().action())
 	defer cancel()
 	call, err := future.Get(ctx)
 	if err != nil {
@@ -1,9 +1,12 @@
 package control
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/retrozoid/control/protocol/common"
+	"github.com/retrozoid/control/protocol/network"
 	"github.com/retrozoid/control/protocol/page"
 )
 
@@ -22,7 +25,10 @@ const (
 	LifecycleNetworkAlmostIdle             LifecycleEventType = "networkAlmostIdle"
 )
 
-var ErrNavigateNoLoader = errors.New("navigation to the same address")
+var (
+	ErrNavigateNoLoader   = errors.New("navigation to the same address")
+	ErrNavigationReplaced = errors.New("navigation was replaced by another one")
+)
 
 type Queryable interface {
 	Query(string) Optional[*Node]
@@ -94,6 +100,32 @@ func (f Frame) MustNavigate(url string) {
 	}
 }
 
+// NavigateAndWait navigates the frame and waits for the lifecycle event of the new document
+func (f Frame) NavigateAndWait(url string, event LifecycleEventType, timeout time.Duration) error {
+	return f.waitLifecycle(event, timeout, func() (network.LoaderId, error) {
+		nav, err := page.Navigate(f, page.NavigateArgs{
+			Url:     url,
+			FrameId: f.id,
+		})
+		if err != nil {
+			return "", err
+		}
+		if nav.ErrorText != "" {
+			return "", errors.New(nav.ErrorText)
+		}
+		if nav.LoaderId == "" {
+			return "", ErrNavigateNoLoader
+		}
+		return nav.LoaderId, nil
+	})
+}
+
+func (f Frame) MustNavigateAndWait(url string, event LifecycleEventType, timeout time.Duration) {
+	if err := f.NavigateAndWait(url, event, timeout); err != nil {
+		panic(err)
+	}
+}
+
 func (f Frame) Reload(ignoreCache bool, scriptToEvaluateOnLoad string) error {
 	return page.Reload(f, page.ReloadArgs{
 		IgnoreCache:            ignoreCache,
@@ -107,6 +139,71 @@ func (f Frame) MustReload(ignoreCache bool, scriptToEvaluateOnLoad string) {
 	}
 }
 
+func (f Frame) ReloadAndWait(ignoreCache bool, scriptToEvaluateOnLoad string, event LifecycleEventType, timeout time.Duration) error {
+	return f.waitLifecycle(event, timeout, func() (network.LoaderId, error) {
+		return "", f.Reload(ignoreCache, scriptToEvaluateOnLoad)
+	})
+}
+
+func (f Frame) MustReloadAndWait(ignoreCache bool, scriptToEvaluateOnLoad string, event LifecycleEventType, timeout time.Duration) {
+	if err := f.ReloadAndWait(ignoreCache, scriptToEvaluateOnLoad, event, timeout); err != nil {
+		panic(err)
+	}
+}
+
+// waitLifecycle subscribes to the frame lifecycle before running the navigation,
+// so that no event is lost. If the navigation doesn't report its loader,
+// the loader of the first "init" event of the frame is waited for.
+func (f Frame) waitLifecycle(event LifecycleEventType, timeout time.Duration, navigate func() (network.LoaderId, error)) error {
+	channel, cancel := f.session.subscribe("Page.navigatedWithinDocument", "Page.lifecycleEvent")
+	defer cancel()
+
+	loaderID, err := navigate()
+	if err != nil {
+		return err
+	}
+	if timeout <= 0 {
+		timeout = f.session.Timeouts().navigation()
+	}
+	ctx, cancelTimeout := f.session.withTimeout(timeout)
+	defer cancelTimeout()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+
+		case message, ok := <-channel:
+			if !ok {
+				return ErrTargetDetached
+			}
+			switch message.Method {
+
+			case "Page.navigatedWithinDocument":
+				within := mustUnmarshal[page.NavigatedWithinDocument](message)
+				if within.FrameId == f.id && loaderID == "" {
+					return nil
+				}
+
+			case "Page.lifecycleEvent":
+				lifecycle := mustUnmarshal[page.LifecycleEvent](message)
+				if lifecycle.FrameId != f.id {
+					continue
+				}
+				if LifecycleEventType(lifecycle.Name) == LifecycleInit && lifecycle.LoaderId != loaderID {
+					if loaderID != "" {
+						return ErrNavigationReplaced
+					}
+					loaderID = lifecycle.LoaderId
+				}
+				if lifecycle.LoaderId == loaderID && LifecycleEventType(lifecycle.Name) == event {
+					return nil
+				}
+			}
+		}
+	}
+}
+
 func (f Frame) Evaluate(expression string, awaitPromise bool) Optional[any] {
 	return optional[any](f.evaluate(expression, awaitPromise))
 }
@@ -122,26 +219,28 @@ func (f Frame) Document() Optional[*Node] {
 	return opt
 }
 
-func (f Frame) MustQuery(cssSelector string) *Node {
-	return f.Query(cssSelector).MustGetValue()
+func (f Frame) MustQuery(selector string) *Node {
+	return f.Query(selector).MustGetValue()
 }
 
-func (f Frame) Query(cssSelector string) Optional[*Node] {
+// Query finds the first element by CSS selector, by selector prefixed with engine name
+// (`xpath=`, `text=`, `role=`, `data-testid=`) or by chain of them separated by `>>`
+func (f Frame) Query(selector string) Optional[*Node] {
 	doc, err := f.Document().Unwrap()
 	if err != nil {
 		return Optional[*Node]{err: err}
 	}
-	return doc.Query(cssSelector)
+	return doc.Query(selector)
 }
 
-func (f Frame) MustQueryAll(cssSelector string) NodeList {
-	return f.QueryAll(cssSelector).MustGetValue()
+func (f Frame) MustQueryAll(selector string) NodeList {
+	return f.QueryAll(selector).MustGetValue()
 }
 
-func (f Frame) QueryAll(cssSelector string) Optional[NodeList] {
+func (f Frame) QueryAll(selector string) Optional[NodeList] {
 	doc, err := f.Document().Unwrap()
 	if err != nil {
 		return Optional[NodeList]{err: err}
 	}
-	return doc.QueryAll(cssSelector)
+	return doc.QueryAll(selector)
 }
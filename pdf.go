@@ -0,0 +1,170 @@
+package control
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+
+	protocolio "github.com/retrozoid/control/protocol/io"
+	"github.com/retrozoid/control/protocol/page"
+)
+
+// PDFChunkSize is the size of data read from the browser at once
+var PDFChunkSize = 1 << 20
+
+// PaperSize is the size of the paper in inches
+type PaperSize struct {
+	Width  float64
+	Height float64
+}
+
+var (
+	PaperLetter  = PaperSize{Width: 8.5, Height: 11}
+	PaperLegal   = PaperSize{Width: 8.5, Height: 14}
+	PaperTabloid = PaperSize{Width: 11, Height: 17}
+	PaperA3      = PaperSize{Width: 11.69, Height: 16.54}
+	PaperA4      = PaperSize{Width: 8.27, Height: 11.69}
+	PaperA5      = PaperSize{Width: 5.83, Height: 8.27}
+)
+
+// PDFMargins are the margins of the page in inches
+type PDFMargins struct {
+	Top    float64
+	Bottom float64
+	Left   float64
+	Right  float64
+}
+
+type PDFOptions struct {
+	// Paper is Letter if it's zero
+	Paper PaperSize
+	// Margins are about 1cm if it's nil
+	Margins   *PDFMargins
+	Landscape bool
+	// Scale of the rendering is 1 if it's zero
+	Scale           float64
+	PrintBackground bool
+	// PageRanges are printed, e.g. "1-5, 8, 11-13", all pages if it's empty
+	PageRanges string
+	// HeaderTemplate and FooterTemplate are HTML with classes date, title, url, pageNumber and totalPages
+	// to inject the values, header and footer are displayed if any of them is set
+	HeaderTemplate    string
+	FooterTemplate    string
+	PreferCSSPageSize bool
+	// Tagged generates accessible PDF
+	Tagged bool
+	// Outline embeds the document outline made of headings
+	Outline bool
+}
+
+// printToPDFArgs sends zero margins unlike page.PrintToPDFArgs
+type printToPDFArgs struct {
+	Landscape               bool     `json:"landscape,omitempty"`
+	DisplayHeaderFooter     bool     `json:"displayHeaderFooter,omitempty"`
+	PrintBackground         bool     `json:"printBackground,omitempty"`
+	Scale                   float64  `json:"scale,omitempty"`
+	PaperWidth              float64  `json:"paperWidth,omitempty"`
+	PaperHeight             float64  `json:"paperHeight,omitempty"`
+	MarginTop               *float64 `json:"marginTop,omitempty"`
+	MarginBottom            *float64 `json:"marginBottom,omitempty"`
+	MarginLeft              *float64 `json:"marginLeft,omitempty"`
+	MarginRight             *float64 `json:"marginRight,omitempty"`
+	PageRanges              string   `json:"pageRanges,omitempty"`
+	HeaderTemplate          string   `json:"headerTemplate,omitempty"`
+	FooterTemplate          string   `json:"footerTemplate,omitempty"`
+	PreferCSSPageSize       bool     `json:"preferCSSPageSize,omitempty"`
+	TransferMode            string   `json:"transferMode"`
+	GenerateTaggedPDF       bool     `json:"generateTaggedPDF,omitempty"`
+	GenerateDocumentOutline bool     `json:"generateDocumentOutline,omitempty"`
+}
+
+// PDF prints the page, the document is streamed from the browser by chunks while it's read.
+// The reader must be closed to release the stream in the browser
+func (s *Session) PDF(opts PDFOptions) (io.ReadCloser, error) {
+	args := printToPDFArgs{
+		Landscape:               opts.Landscape,
+		DisplayHeaderFooter:     opts.HeaderTemplate != "" || opts.FooterTemplate != "",
+		PrintBackground:         opts.PrintBackground,
+		Scale:                   opts.Scale,
+		PaperWidth:              opts.Paper.Width,
+		PaperHeight:             opts.Paper.Height,
+		PageRanges:              opts.PageRanges,
+		HeaderTemplate:          opts.HeaderTemplate,
+		FooterTemplate:          opts.FooterTemplate,
+		PreferCSSPageSize:       opts.PreferCSSPageSize,
+		TransferMode:            "ReturnAsStream",
+		GenerateTaggedPDF:       opts.Tagged,
+		GenerateDocumentOutline: opts.Outline,
+	}
+	if m := opts.Margins; m != nil {
+		args.MarginTop, args.MarginBottom, args.MarginLeft, args.MarginRight = &m.Top, &m.Bottom, &m.Left, &m.Right
+	}
+	if args.DisplayHeaderFooter {
+		// browser prints its default header or footer in place of the empty template
+		if args.HeaderTemplate == "" {
+			args.HeaderTemplate = "<span></span>"
+		}
+		if args.FooterTemplate == "" {
+			args.FooterTemplate = "<span></span>"
+		}
+	}
+	var val page.PrintToPDFVal
+	if err := s.Call("Page.printToPDF", args, &val); err != nil {
+		return nil, err
+	}
+	if val.Stream == "" {
+		return nil, errors.New("browser didn't return a stream of pdf")
+	}
+	return &streamReader{session: s, handle: val.Stream}, nil
+}
+
+func (s *Session) MustPDF(opts PDFOptions) io.ReadCloser {
+	r, err := s.PDF(opts)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// streamReader reads the stream of IO domain
+type streamReader struct {
+	session *Session
+	handle  protocolio.StreamHandle
+	buffer  []byte
+	eof     bool
+	closed  bool
+}
+
+func (r *streamReader) Read(p []byte) (int, error) {
+	if r.closed {
+		return 0, io.ErrClosedPipe
+	}
+	for len(r.buffer) == 0 {
+		if r.eof {
+			return 0, io.EOF
+		}
+		val, err := protocolio.Read(r.session, protocolio.ReadArgs{Handle: r.handle, Size: PDFChunkSize})
+		if err != nil {
+			return 0, err
+		}
+		r.eof = val.Eof
+		r.buffer = []byte(val.Data)
+		if val.Base64Encoded {
+			if r.buffer, err = base64.StdEncoding.DecodeString(val.Data); err != nil {
+				return 0, err
+			}
+		}
+	}
+	n := copy(p, r.buffer)
+	r.buffer = r.buffer[n:]
+	return n, nil
+}
+
+func (r *streamReader) Close() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	r.buffer = nil
+	return protocolio.Close(r.session, protocolio.CloseArgs{Handle: r.handle})
+}
@@ -0,0 +1,431 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"sync"
+
+	"github.com/retrozoid/control/chrome"
+	"github.com/retrozoid/control/protocol/network"
+	"github.com/retrozoid/control/protocol/storage"
+	"github.com/retrozoid/control/protocol/target"
+)
+
+var (
+	ErrPoolClosed       = errors.New("pool closed")
+	ErrSessionNotLeased = errors.New("session is not leased from the pool")
+)
+
+type PoolOptions struct {
+	// MinBrowsers are launched by NewPool and relaunched after crashes
+	MinBrowsers int
+	// MaxBrowsers bounds launched browsers, 1 if it's zero
+	MaxBrowsers int
+	// MaxPagesPerBrowser bounds open pages of every browser, 1 if it's zero
+	MaxPagesPerBrowser int
+	// Isolated opens every leased page in its own browser context, which is disposed on release
+	Isolated bool
+	// Timeouts of the browser connections and the leased pages, DefaultTimeouts if it's zero
+	Timeouts Timeouts
+	Launch   chrome.LaunchOptions
+	Logger   *slog.Logger
+}
+
+type PoolStats struct {
+	Browsers int
+	Pages    int
+	Leased   int
+	Idle     int
+	Launched int
+	Crashed  int
+	Acquired int
+}
+
+// pooledBrowser is registered before its launch, ready is closed when it's launched or failed with err
+type pooledBrowser struct {
+	conn   *Connection
+	exited <-chan struct{}
+	close  func()
+	ready  chan struct{}
+	err    error
+	pages  int
+	dead   bool
+}
+
+type lease struct {
+	browser *pooledBrowser
+	session *Session
+	context *BrowserContext
+}
+
+// Pool keeps browsers warm and leases pages of them
+type Pool struct {
+	ctx      context.Context
+	cancel   func()
+	opts     PoolOptions
+	start    func(ctx context.Context) (*pooledBrowser, error)
+	slots    chan struct{}
+	mutex    sync.Mutex
+	browsers []*pooledBrowser
+	leased   map[*Session]*lease
+	idle     []*lease
+	stats    PoolStats
+	closed   bool
+}
+
+func NewPool(ctx context.Context, opts PoolOptions) (*Pool, error) {
+	return newPool(ctx, opts, func(ctx context.Context) (*pooledBrowser, error) {
+		browser, conn, err := launch(ctx, opts.Logger, opts.Launch)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Timeouts != (Timeouts{}) {
+			conn.SetTimeouts(opts.Timeouts)
+		}
+		return &pooledBrowser{
+			conn:   conn,
+			exited: browser.Exited(),
+			close:  func() { closeBrowser(browser, conn) },
+		}, nil
+	})
+}
+
+// newPool makes the pool of browsers started by the function
+func newPool(ctx context.Context, opts PoolOptions, start func(ctx context.Context) (*pooledBrowser, error)) (*Pool, error) {
+	if opts.MaxBrowsers < 1 {
+		opts.MaxBrowsers = 1
+	}
+	if opts.MaxPagesPerBrowser < 1 {
+		opts.MaxPagesPerBrowser = 1
+	}
+	if opts.MinBrowsers > opts.MaxBrowsers {
+		opts.MinBrowsers = opts.MaxBrowsers
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	p := &Pool{
+		ctx:    ctx,
+		cancel: cancel,
+		opts:   opts,
+		start:  start,
+		slots:  make(chan struct{}, opts.MaxBrowsers*opts.MaxPagesPerBrowser),
+		leased: map[*Session]*lease{},
+	}
+	for n := 0; n < opts.MinBrowsers; n++ {
+		p.mutex.Lock()
+		value := p.reserve()
+		p.mutex.Unlock()
+		if err := p.launch(value); err != nil {
+			return nil, errors.Join(err, p.Close())
+		}
+	}
+	return p, nil
+}
+
+func (p *Pool) log(msg string, args ...any) {
+	if p.opts.Logger != nil {
+		p.opts.Logger.Warn(msg, args...)
+	}
+}
+
+// reserve registers the browser to be launched, so concurrent acquires wait for it instead of launching one more.
+// Must be called under the lock
+func (p *Pool) reserve() *pooledBrowser {
+	value := &pooledBrowser{ready: make(chan struct{})}
+	p.browsers = append(p.browsers, value)
+	return value
+}
+
+// launch starts the reserved browser, must be called without the lock
+func (p *Pool) launch(value *pooledBrowser) error {
+	started, err := p.start(p.ctx)
+	p.mutex.Lock()
+	closed := p.closed
+	switch {
+	case err != nil:
+		value.err, value.dead = err, true
+		p.removeBrowser(value)
+	case closed:
+		value.err, value.dead = ErrPoolClosed, true
+		p.removeBrowser(value)
+	default:
+		value.conn, value.exited, value.close = started.conn, started.exited, started.close
+		p.stats.Launched++
+	}
+	p.mutex.Unlock()
+	close(value.ready)
+	switch {
+	case err != nil:
+		return err
+	case closed:
+		started.close()
+		return ErrPoolClosed
+	}
+	go p.watch(value)
+	return nil
+}
+
+// watch removes the crashed browser and launches a replacement if the pool lacks MinBrowsers
+func (p *Pool) watch(value *pooledBrowser) {
+	<-value.exited
+	p.mutex.Lock()
+	value.dead = true
+	p.removeBrowser(value)
+	// idle pages already gave their slots back on release
+	idle := p.idle[:0]
+	for _, l := range p.idle {
+		if l.browser != value {
+			idle = append(idle, l)
+		} else {
+			value.pages--
+		}
+	}
+	p.idle = idle
+	relaunch := !p.closed && len(p.browsers) < p.opts.MinBrowsers
+	if !p.closed {
+		p.stats.Crashed++
+	}
+	var replacement *pooledBrowser
+	if relaunch {
+		replacement = p.reserve()
+	}
+	p.mutex.Unlock()
+	if relaunch {
+		if err := p.launch(replacement); err != nil {
+			p.log("can't relaunch crashed browser", "err", err.Error())
+		}
+	}
+}
+
+func (p *Pool) removeBrowser(value *pooledBrowser) {
+	for n, b := range p.browsers {
+		if b == value {
+			p.browsers = append(p.browsers[:n], p.browsers[n+1:]...)
+			return
+		}
+	}
+}
+
+// Acquire leases a page waiting for a free slot if all of them are busy
+func (p *Pool) Acquire(ctx context.Context) (*Session, error) {
+	select {
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	case <-p.ctx.Done():
+		return nil, ErrPoolClosed
+	case p.slots <- struct{}{}:
+	}
+	session, err := p.acquire(ctx)
+	if err != nil {
+		<-p.slots
+		return nil, err
+	}
+	return session, nil
+}
+
+func (p *Pool) MustAcquire(ctx context.Context) *Session {
+	session, err := p.Acquire(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return session
+}
+
+func (p *Pool) acquire(ctx context.Context) (*Session, error) {
+	p.mutex.Lock()
+	if p.closed {
+		p.mutex.Unlock()
+		return nil, ErrPoolClosed
+	}
+	for len(p.idle) > 0 {
+		value := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		if value.browser.dead || value.session.IsDone() {
+			value.browser.pages--
+			continue
+		}
+		p.leased[value.session] = value
+		p.stats.Acquired++
+		p.mutex.Unlock()
+		return value.session, nil
+	}
+	var browser *pooledBrowser
+	for _, b := range p.browsers {
+		if !b.dead && b.pages < p.opts.MaxPagesPerBrowser {
+			browser = b
+			break
+		}
+	}
+	// a free slot guarantees there is room for one more browser, launched ones included
+	reserved := browser == nil
+	if reserved {
+		browser = p.reserve()
+	}
+	browser.pages++
+	p.mutex.Unlock()
+
+	if reserved {
+		// the browser stays in the pool if the caller gives up waiting for it
+		go func() {
+			if err := p.launch(browser); err != nil {
+				p.log("can't launch browser", "err", err.Error())
+			}
+		}()
+	}
+	select {
+	case <-ctx.Done():
+		p.mutex.Lock()
+		browser.pages--
+		p.mutex.Unlock()
+		return nil, context.Cause(ctx)
+	case <-browser.ready:
+	}
+	if browser.err != nil {
+		p.mutex.Lock()
+		browser.pages--
+		p.mutex.Unlock()
+		return nil, browser.err
+	}
+	value, err := p.open(ctx, browser)
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if err != nil {
+		browser.pages--
+		return nil, err
+	}
+	p.leased[value.session] = value
+	p.stats.Acquired++
+	return value.session, nil
+}
+
+// open opens the page of the browser, its round-trips are cancelled with ctx as well
+func (p *Pool) open(ctx context.Context, browser *pooledBrowser) (value *lease, err error) {
+	value = &lease{browser: browser}
+	conn := browser.conn.WithContext(ctx)
+	if !p.opts.Isolated {
+		value.session, err = conn.NewPage("")
+		return value, err
+	}
+	if value.context, err = newBrowserContext(conn, BrowserContextOptions{}); err != nil {
+		return nil, err
+	}
+	// the context outlives ctx, it's disposed on release
+	value.context.caller = browser.conn
+	if value.session, err = value.context.WithContext(ctx).NewPage(""); err != nil {
+		return nil, errors.Join(err, value.context.Dispose())
+	}
+	return value, nil
+}
+
+// Release resets the page and returns it to the pool, the page is closed if it can't be reused
+func (p *Pool) Release(session *Session) error {
+	p.mutex.Lock()
+	value, ok := p.leased[session]
+	delete(p.leased, session)
+	p.mutex.Unlock()
+	if !ok {
+		return ErrSessionNotLeased
+	}
+	defer func() { <-p.slots }()
+
+	var err error
+	if value.context == nil && !session.IsDone() {
+		if err = p.reset(value); err == nil {
+			p.mutex.Lock()
+			defer p.mutex.Unlock()
+			if !p.closed && !value.browser.dead {
+				p.idle = append(p.idle, value)
+				return nil
+			}
+			value.browser.pages--
+			return nil
+		}
+	}
+	// isolated, crashed or dirty page is not reused
+	p.mutex.Lock()
+	value.browser.pages--
+	p.mutex.Unlock()
+	if value.context != nil {
+		return value.context.Dispose()
+	}
+	if !session.IsDone() {
+		err = errors.Join(err, session.Close())
+	}
+	return err
+}
+
+func (p *Pool) MustRelease(session *Session) {
+	if err := p.Release(session); err != nil {
+		panic(err)
+	}
+}
+
+// reset closes the targets opened by the page, clears the storage of its origin and navigates it to the blank page
+func (p *Pool) reset(value *lease) error {
+	session := value.session
+	targets, err := target.GetTargets(value.browser.conn, target.GetTargetsArgs{})
+	if err != nil {
+		return err
+	}
+	for _, info := range targets.TargetInfos {
+		if info.OpenerId == session.targetID {
+			if _, err = target.CloseTarget(value.browser.conn, target.CloseTargetArgs{TargetId: info.TargetId}); err != nil {
+				return err
+			}
+		}
+	}
+	if current, err := session.GetCurrentURL().Unwrap(); err == nil {
+		if u, err := url.Parse(current); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+			if err = storage.ClearDataForOrigin(session, storage.ClearDataForOriginArgs{
+				Origin:       u.Scheme + "://" + u.Host,
+				StorageTypes: "all",
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	// cookies are shared by the pages of the browser, ClearDataForOrigin has already cleared the ones of the page origin
+	if p.opts.MaxPagesPerBrowser == 1 {
+		if err = network.ClearBrowserCookies(session); err != nil {
+			return err
+		}
+	}
+	return session.Frame.Navigate(Blank)
+}
+
+func (p *Pool) Stats() PoolStats {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	stats := p.stats
+	stats.Browsers = len(p.browsers)
+	stats.Leased = len(p.leased)
+	stats.Idle = len(p.idle)
+	for _, b := range p.browsers {
+		stats.Pages += b.pages
+	}
+	return stats
+}
+
+// Close closes all browsers of the pool including leased pages
+func (p *Pool) Close() error {
+	p.mutex.Lock()
+	if p.closed {
+		p.mutex.Unlock()
+		return nil
+	}
+	p.closed = true
+	var browsers []*pooledBrowser
+	for _, b := range p.browsers {
+		// browsers being launched are closed by launch
+		if b.close != nil {
+			browsers = append(browsers, b)
+		}
+	}
+	p.mutex.Unlock()
+	for _, b := range browsers {
+		b.close()
+	}
+	p.cancel()
+	return nil
+}
@@ -0,0 +1,182 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/retrozoid/control/cdptest"
+)
+
+// fakeBrowsers starts browsers of the pool as cdptest servers, crash makes the browser exit
+type fakeBrowsers struct {
+	mutex   sync.Mutex
+	crashes []func()
+	// setup adds handlers to the server of every browser
+	setup func(server *cdptest.Server)
+}
+
+func (f *fakeBrowsers) start(ctx context.Context) (*pooledBrowser, error) {
+	server := cdptest.NewServer()
+	server.Handle("Page.getNavigationHistory", cdptest.Result(map[string]any{"currentIndex": -1, "entries": []any{}}))
+	server.Handle("Page.navigate", cdptest.Result(map[string]any{"loaderId": "loader"}))
+	if f.setup != nil {
+		f.setup(server)
+	}
+	conn, err := Connect(ctx, server.URL())
+	if err != nil {
+		server.Close()
+		return nil, err
+	}
+	var (
+		exited = make(chan struct{})
+		once   sync.Once
+		exit   = func() {
+			once.Do(func() {
+				_ = conn.Close()
+				server.Close()
+				close(exited)
+			})
+		}
+	)
+	f.mutex.Lock()
+	f.crashes = append(f.crashes, exit)
+	f.mutex.Unlock()
+	return &pooledBrowser{conn: conn, exited: exited, close: exit}, nil
+}
+
+func (f *fakeBrowsers) crash(n int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.crashes[n]()
+}
+
+func newTestPool(t *testing.T, opts PoolOptions, setup ...func(*cdptest.Server)) (*Pool, *fakeBrowsers, context.Context) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	t.Cleanup(cancel)
+	browsers := &fakeBrowsers{}
+	if len(setup) > 0 {
+		browsers.setup = setup[0]
+	}
+	pool, err := newPool(ctx, opts, browsers.start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = pool.Close() })
+	return pool, browsers, ctx
+}
+
+// checkPages fails if any browser has more pages than allowed
+func checkPages(t *testing.T, p *Pool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if len(p.browsers) > p.opts.MaxBrowsers {
+		t.Errorf("%d browsers launched, %d allowed", len(p.browsers), p.opts.MaxBrowsers)
+	}
+	for n, b := range p.browsers {
+		if b.pages > p.opts.MaxPagesPerBrowser {
+			t.Errorf("browser %d has %d pages, %d allowed", n, b.pages, p.opts.MaxPagesPerBrowser)
+		}
+	}
+}
+
+func TestPoolConcurrentAcquire(t *testing.T) {
+	for _, opts := range []PoolOptions{
+		{MaxBrowsers: 2, MaxPagesPerBrowser: 1},
+		{MaxBrowsers: 2, MaxPagesPerBrowser: 3},
+		{MaxBrowsers: 3, MaxPagesPerBrowser: 2, Isolated: true},
+	} {
+		pool, _, ctx := newTestPool(t, opts)
+		var (
+			wg      sync.WaitGroup
+			workers = 2 * opts.MaxBrowsers * opts.MaxPagesPerBrowser
+			rounds  = 10
+		)
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for r := 0; r < rounds; r++ {
+					session, err := pool.Acquire(ctx)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					checkPages(t, pool)
+					if err = pool.Release(session); err != nil {
+						t.Error(err)
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		stats := pool.Stats()
+		if stats.Acquired != workers*rounds || stats.Leased != 0 || stats.Launched != stats.Browsers || stats.Browsers > opts.MaxBrowsers {
+			t.Errorf("%+v: unexpected stats %+v", opts, stats)
+		}
+		// leased pages give their slots back, idle ones are counted as pages of their browsers
+		if len(pool.slots) != 0 || stats.Pages != stats.Idle || opts.Isolated && stats.Idle != 0 {
+			t.Errorf("%+v: %d slots are taken, %d pages, %d idle", opts, len(pool.slots), stats.Pages, stats.Idle)
+		}
+	}
+}
+
+func TestPoolCrashedBrowser(t *testing.T) {
+	pool, browsers, ctx := newTestPool(t, PoolOptions{MinBrowsers: 1, MaxPagesPerBrowser: 2})
+	first, err := pool.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := pool.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = pool.Release(first); err != nil {
+		t.Fatal(err)
+	}
+	if stats := pool.Stats(); stats.Browsers != 1 || stats.Pages != 2 || stats.Idle != 1 || stats.Leased != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+
+	browsers.crash(0)
+	for pool.Stats().Launched < 2 || pool.Stats().Browsers < 1 {
+		select {
+		case <-ctx.Done():
+			t.Fatal("crashed browser isn't relaunched")
+		case <-time.After(time.Millisecond):
+		}
+	}
+	// the idle page of the crashed browser is dropped, the leased one is counted until its release
+	if stats := pool.Stats(); stats.Crashed != 1 || stats.Pages != 0 || stats.Idle != 0 || stats.Leased != 1 {
+		t.Errorf("unexpected stats after the crash %+v", stats)
+	}
+	_ = pool.Release(second)
+	if len(pool.slots) != 0 {
+		t.Errorf("%d slots are taken", len(pool.slots))
+	}
+	session, err := pool.Acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.MustRelease(session)
+	checkPages(t, pool)
+}
+
+func TestPoolAcquireBoundByContext(t *testing.T) {
+	pool, _, ctx := newTestPool(t, PoolOptions{MinBrowsers: 1}, func(server *cdptest.Server) {
+		hang(t, server, "Target.createTarget")
+	})
+	acquireCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := pool.Acquire(acquireCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("opening the page isn't bound by the context of Acquire: %v", err)
+	}
+	if stats := pool.Stats(); stats.Pages != 0 || stats.Leased != 0 || len(pool.slots) != 0 {
+		t.Errorf("cancelled acquire keeps its page %+v, %d slots", stats, len(pool.slots))
+	}
+}
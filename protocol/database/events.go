@@ -1,7 +0,0 @@
-package database
-
-/*
- */
-type AddDatabase struct {
-	Database *Database `json:"database"`
-}
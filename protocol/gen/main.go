@@ -0,0 +1,522 @@
+// Command gen generates protocol packages from DevTools protocol schema.
+//
+//	go run ./gen -schema json -out . -revision 1495869
+//
+// With -verify nothing is written, the command fails if the generated files differ from the ones on disk
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	modulePath = "github.com/retrozoid/control/protocol"
+	header     = "// Code generated by protocol/gen. DO NOT EDIT.\n\n"
+	// base64Suffix ends the description of binary data
+	base64Suffix = "(Encoded as a base64 string when passed over JSON)"
+)
+
+// commonTypes are moved to the common package to break import cycles between domains
+var commonTypes = map[string]bool{
+	"Browser.BrowserContextID":        true,
+	"DOM.Rect":                        true,
+	"Emulation.UserAgentMetadata":     true,
+	"Emulation.UserAgentBrandVersion": true,
+	"Network.TimeSinceEpoch":          true,
+	"Page.FrameId":                    true,
+}
+
+// skipped items import their domains back
+var skipped = map[string]string{
+	"Page.setDeviceMetricsOverride": "deprecated duplicate of Emulation.setDeviceMetricsOverride",
+}
+
+type Schema struct {
+	Version struct {
+		Major string `json:"major"`
+		Minor string `json:"minor"`
+	} `json:"version"`
+	Domains []*Domain `json:"domains"`
+}
+
+type Domain struct {
+	Domain       string     `json:"domain"`
+	Description  string     `json:"description"`
+	Experimental bool       `json:"experimental"`
+	Deprecated   bool       `json:"deprecated"`
+	Types        []*Type    `json:"types"`
+	Commands     []*Command `json:"commands"`
+	Events       []*Command `json:"events"`
+}
+
+type Type struct {
+	ID           string      `json:"id"`
+	Description  string      `json:"description"`
+	Type         string      `json:"type"`
+	Items        *Property   `json:"items"`
+	Properties   []*Property `json:"properties"`
+	Experimental bool        `json:"experimental"`
+	Deprecated   bool        `json:"deprecated"`
+}
+
+type Property struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Ref         string    `json:"$ref"`
+	Type        string    `json:"type"`
+	Items       *Property `json:"items"`
+	Optional    bool      `json:"optional"`
+}
+
+type Command struct {
+	Name         string      `json:"name"`
+	Description  string      `json:"description"`
+	Parameters   []*Property `json:"parameters"`
+	Returns      []*Property `json:"returns"`
+	Experimental bool        `json:"experimental"`
+	Deprecated   bool        `json:"deprecated"`
+}
+
+func main() {
+	var (
+		schemaDir = flag.String("schema", "json", "directory of browser_protocol.json and js_protocol.json")
+		outDir    = flag.String("out", ".", "directory of generated packages")
+		revision  = flag.String("revision", "", "revision of the schema the packages are generated from")
+		verify    = flag.Bool("verify", false, "compare generated files with the ones on disk instead of writing")
+	)
+	flag.Parse()
+	if err := run(*schemaDir, *outDir, *revision, *verify); err != nil {
+		fmt.Fprintln(os.Stderr, "gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(schemaDir, outDir, revision string, verify bool) error {
+	var schema Schema
+	for _, name := range []string{"browser_protocol.json", "js_protocol.json"} {
+		b, err := os.ReadFile(filepath.Join(schemaDir, name))
+		if err != nil {
+			return err
+		}
+		var value Schema
+		if err = json.Unmarshal(b, &value); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		schema.Version = value.Version
+		// deprecated domains are left out, their items are marked as deprecated otherwise
+		for _, d := range value.Domains {
+			if !d.Deprecated {
+				schema.Domains = append(schema.Domains, d)
+			}
+		}
+	}
+	files, err := Generate(&schema, revision)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var stale []string
+	for _, name := range names {
+		path := filepath.Join(outDir, name)
+		if verify {
+			b, err := os.ReadFile(path)
+			if err != nil || !bytes.Equal(b, files[name]) {
+				stale = append(stale, name)
+			}
+			continue
+		}
+		if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err = os.WriteFile(path, files[name], 0o644); err != nil {
+			return err
+		}
+	}
+	// files of the domains removed from the schema
+	generated, err := filepath.Glob(filepath.Join(outDir, "*", "*.go"))
+	if err != nil {
+		return err
+	}
+	for _, path := range generated {
+		name, err := filepath.Rel(outDir, path)
+		if err != nil {
+			return err
+		}
+		if _, ok := files[filepath.ToSlash(name)]; ok {
+			continue
+		}
+		b, err := os.ReadFile(path)
+		if err != nil || !bytes.HasPrefix(b, []byte(header)) {
+			continue
+		}
+		if verify {
+			stale = append(stale, name)
+		} else if err = os.Remove(path); err != nil {
+			return err
+		}
+	}
+	if len(stale) > 0 {
+		return fmt.Errorf("generated files are out of date, run go generate: %s", strings.Join(stale, ", "))
+	}
+	return nil
+}
+
+// Generate returns formatted sources by their path relative to the protocol package
+func Generate(schema *Schema, revision string) (map[string][]byte, error) {
+	g := &generator{types: map[string]*Type{}}
+	for _, d := range schema.Domains {
+		for _, t := range d.Types {
+			g.types[d.Domain+"."+t.ID] = t
+		}
+	}
+	files := map[string][]byte{}
+	add := func(name string, src []byte) error {
+		b, err := format.Source(src)
+		if err != nil {
+			return fmt.Errorf("%s: %w\n%s", name, err, src)
+		}
+		files[name] = b
+		return nil
+	}
+
+	common := &Domain{Domain: "common"}
+	for _, d := range schema.Domains {
+		for _, t := range d.Types {
+			if commonTypes[d.Domain+"."+t.ID] {
+				common.Types = append(common.Types, t)
+			}
+		}
+	}
+	graph := map[string][]string{}
+	for _, d := range append([]*Domain{common}, schema.Domains...) {
+		pkg := packageName(d.Domain)
+		source := d.Domain
+		if d == common {
+			source = ""
+		}
+		for name, f := range map[string]*file{
+			"types.go":   g.typesFile(d, source),
+			"methods.go": g.methodsFile(d, source),
+			"events.go":  g.eventsFile(d, source),
+		} {
+			if err := add(pkg+"/"+name, f.bytes()); err != nil {
+				return nil, err
+			}
+			for path := range f.imports {
+				if imported, ok := strings.CutPrefix(path, modulePath+"/"); ok {
+					graph[pkg] = append(graph[pkg], imported)
+				}
+			}
+		}
+	}
+	if err := checkCycles(graph); err != nil {
+		return nil, err
+	}
+	version := fmt.Sprintf(header+"package protocol\n\n// Version of the protocol schema the packages are generated from\nconst Version = %q\n\n// Revision of the schema, the one of Chromium that has published it\nconst Revision = %q\n",
+		schema.Version.Major+"."+schema.Version.Minor, revision)
+	if err := add("version.go", []byte(version)); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+type generator struct {
+	types map[string]*Type
+}
+
+func domainOf(key string) string {
+	domain, _, _ := strings.Cut(key, ".")
+	return domain
+}
+
+func packageName(domain string) string {
+	return strings.ToLower(domain)
+}
+
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// resolve returns the full key of the reference made from the domain
+func (g *generator) resolve(domain, ref string) string {
+	if strings.Contains(ref, ".") {
+		return ref
+	}
+	return domain + "." + ref
+}
+
+// file collects imports of a generated file
+type file struct {
+	pkg     string
+	domain  string
+	imports map[string]bool
+	body    bytes.Buffer
+}
+
+func (g *generator) newFile(d *Domain, source string) *file {
+	return &file{pkg: packageName(d.Domain), domain: source, imports: map[string]bool{}}
+}
+
+func (f *file) bytes() []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package " + f.pkg + "\n\n")
+	if len(f.imports) > 0 {
+		var imports []string
+		for path := range f.imports {
+			imports = append(imports, path)
+		}
+		sort.Strings(imports)
+		b.WriteString("import (\n")
+		for _, path := range imports {
+			fmt.Fprintf(&b, "\t%q\n", path)
+		}
+		b.WriteString(")\n\n")
+	}
+	b.Write(f.body.Bytes())
+	return b.Bytes()
+}
+
+// typeRef returns Go type of the reference, objects are referenced by pointer
+func (g *generator) typeRef(f *file, ref string) string {
+	key := g.resolve(f.domain, ref)
+	t, ok := g.types[key]
+	name := key[strings.Index(key, ".")+1:]
+	var qualified string
+	switch {
+	case commonTypes[key]:
+		if f.pkg == "common" {
+			qualified = name
+		} else {
+			f.imports[modulePath+"/common"] = true
+			qualified = "common." + name
+		}
+	case domainOf(key) == f.domain:
+		qualified = name
+	default:
+		pkg := packageName(domainOf(key))
+		f.imports[modulePath+"/"+pkg] = true
+		qualified = pkg + "." + name
+	}
+	if ok && t.Type == "object" {
+		return "*" + qualified
+	}
+	return qualified
+}
+
+func (g *generator) goType(f *file, p *Property) string {
+	if p.Ref != "" {
+		return g.typeRef(f, p.Ref)
+	}
+	// binary type of pdl is described as string
+	if p.Type == "string" && strings.HasSuffix(p.Description, base64Suffix) {
+		return "[]byte"
+	}
+	return g.primitive(f, p.Type, p.Items)
+}
+
+func (g *generator) primitive(f *file, kind string, items *Property) string {
+	switch kind {
+	case "string":
+		return "string"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "binary":
+		return "[]byte"
+	case "array":
+		return "[]" + g.goType(f, items)
+	default:
+		return "interface{}"
+	}
+}
+
+// comment writes the doc comment the way it has always been written,
+// multiline description is indented to keep its first line apart
+func comment(b *bytes.Buffer, description string, experimental, deprecated bool) {
+	var markers []string
+	if experimental {
+		markers = append(markers, "Experimental: this API may change or be removed without notice.")
+	}
+	if deprecated {
+		markers = append(markers, "Deprecated: the browser may stop supporting it.")
+	}
+	description = strings.ReplaceAll(description, "*/", "* /")
+	if strings.Contains(description, "\n") {
+		description = "\t" + description
+	}
+	switch {
+	case description == "" && len(markers) == 0:
+		b.WriteString("/*\n */\n")
+		return
+	case description == "":
+		description = strings.Join(markers, "\n\n")
+	case len(markers) > 0:
+		description += "\n\n" + strings.Join(markers, "\n\n")
+	}
+	b.WriteString("/*\n" + description + "\n*/\n")
+}
+
+func (g *generator) structBody(f *file, properties []*Property) string {
+	var b strings.Builder
+	b.WriteString("struct {\n")
+	for _, p := range properties {
+		tag := p.Name
+		if p.Optional {
+			tag += ",omitempty"
+		}
+		fmt.Fprintf(&b, "\t%s %s `json:\"%s\"`\n", exported(p.Name), g.goType(f, p), tag)
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+func (g *generator) typesFile(d *Domain, source string) *file {
+	f := g.newFile(d, source)
+	for _, t := range d.Types {
+		if source == "" {
+			// common types are resolved from their own domains
+			f.domain = g.domainOfType(t)
+		}
+		comment(&f.body, t.Description, t.Experimental, t.Deprecated)
+		switch {
+		case t.Type == "object" && len(t.Properties) > 0:
+			fmt.Fprintf(&f.body, "type %s %s\n\n", t.ID, g.structBody(f, t.Properties))
+		default:
+			fmt.Fprintf(&f.body, "type %s %s\n\n", t.ID, g.primitive(f, t.Type, t.Items))
+		}
+	}
+	for _, c := range d.Commands {
+		if _, ok := skipped[d.Domain+"."+c.Name]; ok {
+			continue
+		}
+		if len(c.Parameters) > 0 {
+			fmt.Fprintf(&f.body, "type %sArgs %s\n\n", exported(c.Name), g.structBody(f, c.Parameters))
+		}
+		if len(c.Returns) > 0 {
+			fmt.Fprintf(&f.body, "type %sVal %s\n\n", exported(c.Name), g.structBody(f, c.Returns))
+		}
+	}
+	return f
+}
+
+func (g *generator) domainOfType(t *Type) string {
+	for key, value := range g.types {
+		if value == t {
+			return domainOf(key)
+		}
+	}
+	return ""
+}
+
+func (g *generator) methodsFile(d *Domain, source string) *file {
+	f := g.newFile(d, source)
+	for _, c := range d.Commands {
+		if _, ok := skipped[d.Domain+"."+c.Name]; ok {
+			continue
+		}
+		f.imports[modulePath] = true
+		name := exported(c.Name)
+		method := d.Domain + "." + c.Name
+		comment(&f.body, c.Description, c.Experimental, c.Deprecated)
+		switch {
+		case len(c.Parameters) > 0 && len(c.Returns) > 0:
+			fmt.Fprintf(&f.body, "func %s(c protocol.Caller, args %sArgs) (*%sVal, error) {\n\tvar val = &%sVal{}\n\treturn val, c.Call(%q, args, val)\n}\n\n", name, name, name, name, method)
+		case len(c.Parameters) > 0:
+			fmt.Fprintf(&f.body, "func %s(c protocol.Caller, args %sArgs) error {\n\treturn c.Call(%q, args, nil)\n}\n\n", name, name, method)
+		case len(c.Returns) > 0:
+			fmt.Fprintf(&f.body, "func %s(c protocol.Caller) (*%sVal, error) {\n\tvar val = &%sVal{}\n\treturn val, c.Call(%q, nil, val)\n}\n\n", name, name, name, method)
+		default:
+			fmt.Fprintf(&f.body, "func %s(c protocol.Caller) error {\n\treturn c.Call(%q, nil, nil)\n}\n\n", name, method)
+		}
+	}
+	return f
+}
+
+func (g *generator) eventsFile(d *Domain, source string) *file {
+	f := g.newFile(d, source)
+	for _, e := range d.Events {
+		comment(&f.body, e.Description, e.Experimental, e.Deprecated)
+		if len(e.Parameters) == 0 {
+			fmt.Fprintf(&f.body, "type %s interface{}\n\n", exported(e.Name))
+		} else {
+			fmt.Fprintf(&f.body, "type %s %s\n\n", exported(e.Name), g.structBody(f, e.Parameters))
+		}
+	}
+	if len(d.Events) > 0 {
+		f.body.WriteString("// Method names of the events\nconst (\n")
+		for _, e := range d.Events {
+			fmt.Fprintf(&f.body, "\t%sMethod = %q\n", exported(e.Name), d.Domain+"."+e.Name)
+		}
+		f.body.WriteString(")\n\n")
+	}
+	for _, e := range d.Events {
+		f.imports[modulePath] = true
+		name := exported(e.Name)
+		fmt.Fprintf(&f.body, "/*\nOn%s calls the handler on every %s.%s event.\n*/\nfunc On%s(s protocol.Subscriber, handler func(%s) error) (unsubscribe func()) {\n\treturn protocol.On(s, %sMethod, handler)\n}\n\n",
+			name, d.Domain, e.Name, name, name, name)
+	}
+	return f
+}
+
+// checkCycles reports import cycle between domains, the types making it should be moved to commonTypes
+func checkCycles(graph map[string][]string) error {
+	var (
+		pkgs  []string
+		state = map[string]int{}
+		stack []string
+		visit func(string) error
+	)
+	const (
+		visiting = iota + 1
+		visited
+	)
+	visit = func(pkg string) error {
+		switch state[pkg] {
+		case visiting:
+			return fmt.Errorf("import cycle %s -> %s", strings.Join(stack, " -> "), pkg)
+		case visited:
+			return nil
+		}
+		state[pkg] = visiting
+		stack = append(stack, pkg)
+		next := append([]string{}, graph[pkg]...)
+		sort.Strings(next)
+		for _, to := range next {
+			if err := visit(to); err != nil {
+				return err
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[pkg] = visited
+		return nil
+	}
+	for pkg := range graph {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+	for _, pkg := range pkgs {
+		if err := visit(pkg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
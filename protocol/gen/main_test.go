@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files of testdata")
+
+const (
+	testSchema = "testdata/json"
+	testGolden = "testdata/golden"
+)
+
+func TestGenerateGolden(t *testing.T) {
+	if *update {
+		if err := run(testSchema, testGolden, "1", false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := run(testSchema, testGolden, "1", true); err != nil {
+		t.Fatalf("%s, run go test ./gen -update if the change is intended", err)
+	}
+}
+
+func TestGenerateSkipsDeprecatedDomain(t *testing.T) {
+	if _, err := os.Stat(filepath.Join(testGolden, "legacy")); !os.IsNotExist(err) {
+		t.Fatalf("deprecated domain is generated: %v", err)
+	}
+}
+
+func TestVerifyReportsStaleFiles(t *testing.T) {
+	dir := t.TempDir()
+	if err := run(testSchema, dir, "1", false); err != nil {
+		t.Fatal(err)
+	}
+	// generated file of the domain removed from the schema and hand-written file next to generated ones
+	removed := filepath.Join(dir, "removed", "types.go")
+	handWritten := filepath.Join(dir, "screen", "helpers.go")
+	if err := os.MkdirAll(filepath.Dir(removed), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(removed, []byte(header+"package removed\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(handWritten, []byte("package screen\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "screen", "types.go"), []byte(header+"package screen\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := run(testSchema, dir, "1", true)
+	if err == nil {
+		t.Fatal("verify passed with stale files")
+	}
+	for _, name := range []string{"screen/types.go", "removed/types.go"} {
+		if !strings.Contains(err.Error(), filepath.FromSlash(name)) && !strings.Contains(err.Error(), name) {
+			t.Errorf("%s is not reported: %s", name, err)
+		}
+	}
+	if strings.Contains(err.Error(), "helpers.go") {
+		t.Errorf("hand-written file is reported: %s", err)
+	}
+
+	if err = run(testSchema, dir, "1", false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(removed); !os.IsNotExist(err) {
+		t.Errorf("generated file of removed domain is kept: %v", err)
+	}
+	if _, err = os.Stat(handWritten); err != nil {
+		t.Errorf("hand-written file is removed: %v", err)
+	}
+	if err = run(testSchema, dir, "1", true); err != nil {
+		t.Errorf("verify failed after generation: %s", err)
+	}
+}
+
+func TestCheckCycles(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		graph map[string][]string
+		cycle bool
+	}{
+		{"empty", map[string][]string{}, false},
+		{"chain", map[string][]string{"page": {"dom"}, "dom": {"runtime"}}, false},
+		{"diamond", map[string][]string{"a": {"b", "c"}, "b": {"d"}, "c": {"d"}}, false},
+		{"self", map[string][]string{"page": {"page"}}, true},
+		{"loop", map[string][]string{"page": {"emulation"}, "emulation": {"page"}}, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if err := checkCycles(test.graph); (err != nil) != test.cycle {
+				t.Errorf("checkCycles() = %v, cycle expected %v", err, test.cycle)
+			}
+		})
+	}
+}
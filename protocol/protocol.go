@@ -1,5 +1,30 @@
+// Package protocol holds the packages of DevTools protocol domains generated from the schema pinned in json directory.
+// To update them replace json/browser_protocol.json and json/js_protocol.json with the ones of
+// https://github.com/ChromeDevTools/devtools-protocol at the wanted revision, set it below and run go generate
 package protocol
 
+//go:generate go run ./gen -schema json -out . -revision 1495869
+
+import "encoding/json"
+
 type Caller interface {
 	Call(method string, send, recv interface{}) error
 }
+
+// Subscriber calls the handler with params of every event of the method until unsubscribe is called
+type Subscriber interface {
+	Listen(method string, handler func(params []byte) error) (unsubscribe func())
+}
+
+// On calls the handler with every event of the method decoded to T
+func On[T any](s Subscriber, method string, handler func(T) error) (unsubscribe func()) {
+	return s.Listen(method, func(params []byte) error {
+		var value T
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &value); err != nil {
+				return err
+			}
+		}
+		return handler(value)
+	})
+}
@@ -0,0 +1,285 @@
+package control
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"io"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/retrozoid/control/protocol/page"
+)
+
+var (
+	ErrRecordingInProgress = errors.New("session is already recording")
+	ErrNotRecording        = errors.New("session is not recording")
+	ErrNoFrames            = errors.New("no frames were captured")
+)
+
+type RecordingFormat string
+
+const (
+	// RecordingMJPEG is Motion JPEG in AVI container
+	RecordingMJPEG RecordingFormat = "mjpeg"
+	// RecordingAPNG is animated PNG
+	RecordingAPNG RecordingFormat = "apng"
+)
+
+// DefaultRecordingFPS is the frame rate of the video, frames are duplicated across idle periods and dropped when they come faster
+var DefaultRecordingFPS = 10
+
+type RecordingOptions struct {
+	// Format is RecordingMJPEG if it's empty
+	Format RecordingFormat
+	// FPS is DefaultRecordingFPS if it's zero
+	FPS int
+	// Quality of captured JPEG frames in range 0..100
+	Quality int
+	// MaxWidth and MaxHeight bound the size of frames, the size of the viewport if they are zero
+	MaxWidth  int
+	MaxHeight int
+	// MaxBytes bounds captured frames, the last frame is held till the end once it's reached, zero is unlimited
+	MaxBytes int64
+}
+
+type recordedFrame struct {
+	offset  int64
+	size    int64
+	time    time.Time
+	arrived time.Time
+}
+
+// recording spools captured frames to the temporary file till StopRecording encodes them
+type recording struct {
+	mutex       sync.Mutex
+	w           io.Writer
+	opts        RecordingOptions
+	spool       *os.File
+	frames      []recordedFrame
+	bytes       int64
+	stopped     bool
+	unsubscribe func()
+}
+
+type recordingState struct {
+	mutex sync.Mutex
+	value *recording
+}
+
+// StartRecording starts the screencast of the page, StopRecording encodes captured frames to w
+func (s *Session) StartRecording(w io.Writer, opts RecordingOptions) error {
+	s.state.recording.mutex.Lock()
+	defer s.state.recording.mutex.Unlock()
+	if s.state.recording.value != nil {
+		return ErrRecordingInProgress
+	}
+	switch opts.Format {
+	case "":
+		opts.Format = RecordingMJPEG
+	case RecordingMJPEG, RecordingAPNG:
+	default:
+		return fmt.Errorf("unsupported recording format `%s`", opts.Format)
+	}
+	if opts.FPS <= 0 {
+		opts.FPS = DefaultRecordingFPS
+	}
+	spool, err := os.CreateTemp("", "control-recording-*")
+	if err != nil {
+		return err
+	}
+	r := &recording{w: w, opts: opts, spool: spool}
+	r.unsubscribe = page.OnScreencastFrame(s, func(frame page.ScreencastFrame) error {
+		// browser doesn't send the next frame until the previous one is acknowledged
+		ackErr := page.ScreencastFrameAck(s, page.ScreencastFrameAckArgs{SessionId: frame.SessionId})
+		return errors.Join(ackErr, r.add(frame))
+	})
+	if err = page.StartScreencast(s, page.StartScreencastArgs{
+		Format:    "jpeg",
+		Quality:   opts.Quality,
+		MaxWidth:  opts.MaxWidth,
+		MaxHeight: opts.MaxHeight,
+	}); err != nil {
+		r.unsubscribe()
+		return errors.Join(err, r.cleanup())
+	}
+	s.state.recording.value = r
+	return nil
+}
+
+func (s *Session) MustStartRecording(w io.Writer, opts RecordingOptions) {
+	if err := s.StartRecording(w, opts); err != nil {
+		panic(err)
+	}
+}
+
+// StopRecording stops the screencast and writes the video, it works after the page is closed as well
+func (s *Session) StopRecording() error {
+	s.state.recording.mutex.Lock()
+	r := s.state.recording.value
+	s.state.recording.value = nil
+	s.state.recording.mutex.Unlock()
+	if r == nil {
+		return ErrNotRecording
+	}
+	var err error
+	if !s.IsDone() {
+		err = page.StopScreencast(s)
+	}
+	r.unsubscribe()
+	r.mutex.Lock()
+	r.stopped = true
+	r.mutex.Unlock()
+	return errors.Join(err, r.encode(time.Now()), r.cleanup())
+}
+
+func (s *Session) MustStopRecording() {
+	if err := s.StopRecording(); err != nil {
+		panic(err)
+	}
+}
+
+func (r *recording) add(frame page.ScreencastFrame) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.stopped || (r.opts.MaxBytes > 0 && r.bytes+int64(len(frame.Data)) > r.opts.MaxBytes && len(r.frames) > 0) {
+		return nil
+	}
+	var (
+		arrived   = time.Now()
+		timestamp = arrived
+	)
+	if frame.Metadata != nil && frame.Metadata.Timestamp > 0 {
+		sec, frac := math.Modf(float64(frame.Metadata.Timestamp))
+		timestamp = time.Unix(int64(sec), int64(frac*1e9))
+	}
+	if _, err := r.spool.Write(frame.Data); err != nil {
+		return err
+	}
+	r.frames = append(r.frames, recordedFrame{offset: r.bytes, size: int64(len(frame.Data)), time: timestamp, arrived: arrived})
+	r.bytes += int64(len(frame.Data))
+	return nil
+}
+
+func (r *recording) cleanup() error {
+	return errors.Join(r.spool.Close(), os.Remove(r.spool.Name()))
+}
+
+func (r *recording) read(n int) ([]byte, error) {
+	b := make([]byte, r.frames[n].size)
+	_, err := r.spool.ReadAt(b, r.frames[n].offset)
+	return b, err
+}
+
+// timeline returns the frame shown at every tick of the video,
+// the last frame lasts till the recording is stopped
+func (r *recording) timeline(stopped time.Time) []int {
+	var (
+		interval = time.Second / time.Duration(r.opts.FPS)
+		first    = r.frames[0].time
+		last     = r.frames[len(r.frames)-1]
+		end      = last.time.Add(stopped.Sub(last.arrived))
+		ticks    = int(end.Sub(first)/interval) + 1
+		frame    = 0
+		sequence = make([]int, ticks)
+	)
+	for tick := range sequence {
+		at := first.Add(time.Duration(tick) * interval)
+		for frame+1 < len(r.frames) && !r.frames[frame+1].time.After(at) {
+			frame++
+		}
+		sequence[tick] = frame
+	}
+	// the last frame is the most interesting one, it must not be dropped
+	sequence[ticks-1] = len(r.frames) - 1
+	return sequence
+}
+
+func (r *recording) encode(stopped time.Time) error {
+	if len(r.frames) == 0 {
+		return ErrNoFrames
+	}
+	head, err := r.read(0)
+	if err != nil {
+		return err
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(head))
+	if err != nil {
+		return err
+	}
+	if err = r.fitFrames(config.Width, config.Height); err != nil {
+		return err
+	}
+	timeline := r.timeline(stopped)
+	switch r.opts.Format {
+	case RecordingAPNG:
+		return r.encodeAPNG(timeline, config.Width, config.Height)
+	default:
+		return r.encodeAVI(timeline, config.Width, config.Height)
+	}
+}
+
+// fitFrames letterboxes the frames of other size than the first one, e.g. captured after the viewport is resized,
+// they are spooled again and replace the original ones
+func (r *recording) fitFrames(width, height int) error {
+	quality := r.opts.Quality
+	if quality <= 0 {
+		quality = jpeg.DefaultQuality
+	}
+	var buf bytes.Buffer
+	for n := 1; n < len(r.frames); n++ {
+		data, err := r.read(n)
+		if err != nil {
+			return err
+		}
+		config, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		if config.Width == width && config.Height == height {
+			continue
+		}
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		buf.Reset()
+		if err = jpeg.Encode(&buf, letterbox(img, width, height), &jpeg.Options{Quality: quality}); err != nil {
+			return err
+		}
+		if _, err = r.spool.WriteAt(buf.Bytes(), r.bytes); err != nil {
+			return err
+		}
+		r.frames[n].offset, r.frames[n].size = r.bytes, int64(buf.Len())
+		r.bytes += int64(buf.Len())
+	}
+	return nil
+}
+
+// letterbox scales the image to fit the size keeping its aspect ratio, the rest is black
+func letterbox(img image.Image, width, height int) *image.RGBA {
+	var (
+		canvas = image.NewRGBA(image.Rect(0, 0, width, height))
+		bounds = img.Bounds()
+		scale  = math.Min(float64(width)/float64(bounds.Dx()), float64(height)/float64(bounds.Dy()))
+		w      = max(1, int(math.Round(float64(bounds.Dx())*scale)))
+		h      = max(1, int(math.Round(float64(bounds.Dy())*scale)))
+		left   = (width - w) / 2
+		top    = (height - h) / 2
+	)
+	draw.Draw(canvas, canvas.Rect, image.Black, image.Point{}, draw.Src)
+	// nearest neighbour is enough for the frames of the same page
+	for y := 0; y < h; y++ {
+		sy := bounds.Min.Y + min(int(float64(y)/scale), bounds.Dy()-1)
+		for x := 0; x < w; x++ {
+			sx := bounds.Min.X + min(int(float64(x)/scale), bounds.Dx()-1)
+			canvas.Set(left+x, top+y, img.At(sx, sy))
+		}
+	}
+	return canvas
+}
@@ -0,0 +1,140 @@
+package control
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+)
+
+const pngSignature = "\x89PNG\r\n\x1a\n"
+
+// apngWriter writes PNG chunks
+type apngWriter struct {
+	w        *bufio.Writer
+	sequence uint32
+	err      error
+}
+
+func (a *apngWriter) chunk(kind string, data []byte) {
+	if a.err != nil {
+		return
+	}
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[:4], uint32(len(data)))
+	copy(header[4:], kind)
+	crc := crc32.NewIEEE()
+	crc.Write(header[4:])
+	crc.Write(data)
+	if _, a.err = a.w.Write(header[:]); a.err != nil {
+		return
+	}
+	if _, a.err = a.w.Write(data); a.err != nil {
+		return
+	}
+	a.err = binary.Write(a.w, binary.BigEndian, crc.Sum32())
+}
+
+func (a *apngWriter) next() uint32 {
+	a.sequence++
+	return a.sequence - 1
+}
+
+// pngChunks splits encoded PNG to header and image data chunks
+func pngChunks(b []byte) (header []byte, data [][]byte, err error) {
+	if !bytes.HasPrefix(b, []byte(pngSignature)) {
+		return nil, nil, errors.New("png: invalid signature")
+	}
+	b = b[len(pngSignature):]
+	for len(b) >= 12 {
+		size := binary.BigEndian.Uint32(b[:4])
+		if uint64(len(b)) < 12+uint64(size) {
+			break
+		}
+		kind, content := string(b[4:8]), b[8:8+size]
+		switch kind {
+		case "IHDR":
+			header = content
+		case "IDAT":
+			data = append(data, content)
+		}
+		b = b[12+size:]
+	}
+	if header == nil || len(data) == 0 {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	return header, data, nil
+}
+
+// encodeAPNG writes animated PNG, the repeated frame is shown longer instead of being duplicated
+func (r *recording) encodeAPNG(timeline []int, width, height int) error {
+	type run struct{ frame, ticks int }
+	var runs []run
+	for tick, frame := range timeline {
+		if tick > 0 && timeline[tick-1] == frame && runs[len(runs)-1].ticks < math.MaxUint16 {
+			runs[len(runs)-1].ticks++
+			continue
+		}
+		runs = append(runs, run{frame: frame, ticks: 1})
+	}
+	var (
+		a      = &apngWriter{w: bufio.NewWriter(r.w)}
+		canvas = image.NewRGBA(image.Rect(0, 0, width, height))
+		buf    bytes.Buffer
+	)
+	if _, err := a.w.WriteString(pngSignature); err != nil {
+		return err
+	}
+	for n, run := range runs {
+		data, err := r.read(run.frame)
+		if err != nil {
+			return err
+		}
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		// frames are opaque and of the same size and color type when the viewport is resized
+		draw.Draw(canvas, canvas.Rect, image.Black, image.Point{}, draw.Src)
+		draw.Draw(canvas, canvas.Rect, img, img.Bounds().Min, draw.Src)
+		buf.Reset()
+		if err = png.Encode(&buf, canvas); err != nil {
+			return err
+		}
+		header, chunks, err := pngChunks(buf.Bytes())
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			a.chunk("IHDR", header)
+			a.chunk("acTL", binary.BigEndian.AppendUint32(binary.BigEndian.AppendUint32(nil, uint32(len(runs))), 0))
+		}
+		frameControl := binary.BigEndian.AppendUint32(nil, a.next())
+		frameControl = binary.BigEndian.AppendUint32(frameControl, uint32(width))
+		frameControl = binary.BigEndian.AppendUint32(frameControl, uint32(height))
+		frameControl = binary.BigEndian.AppendUint32(frameControl, 0) // x offset
+		frameControl = binary.BigEndian.AppendUint32(frameControl, 0) // y offset
+		frameControl = binary.BigEndian.AppendUint16(frameControl, uint16(run.ticks))
+		frameControl = binary.BigEndian.AppendUint16(frameControl, uint16(r.opts.FPS))
+		frameControl = append(frameControl, 0, 0) // dispose and blend operations
+		a.chunk("fcTL", frameControl)
+		for _, chunk := range chunks {
+			if n == 0 {
+				a.chunk("IDAT", chunk)
+			} else {
+				a.chunk("fdAT", append(binary.BigEndian.AppendUint32(nil, a.next()), chunk...))
+			}
+		}
+	}
+	a.chunk("IEND", nil)
+	if a.err != nil {
+		return a.err
+	}
+	return a.w.Flush()
+}
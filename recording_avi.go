@@ -0,0 +1,128 @@
+package control
+
+import (
+	"bufio"
+	"encoding/binary"
+)
+
+const (
+	aviHeaderListSize = 4 + (8 + 56) + (8 + 4 + (8 + 56) + (8 + 40))
+	aviFlagHasIndex   = 0x10
+	aviFlagKeyFrame   = 0x10
+)
+
+// aviWriter writes little endian RIFF structures
+type aviWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (a *aviWriter) write(values ...any) {
+	for _, value := range values {
+		if a.err != nil {
+			return
+		}
+		switch v := value.(type) {
+		case string:
+			_, a.err = a.w.WriteString(v)
+		case []byte:
+			_, a.err = a.w.Write(v)
+		default:
+			a.err = binary.Write(a.w, binary.LittleEndian, v)
+		}
+	}
+}
+
+// encodeAVI writes Motion JPEG in AVI container, the repeated frame is an empty chunk which players treat as
+// the previous frame, so idle periods cost nothing
+func (r *recording) encodeAVI(timeline []int, width, height int) error {
+	var (
+		a         = &aviWriter{w: bufio.NewWriter(r.w)}
+		moviSize  = uint32(4)
+		maxFrame  uint32
+		chunkSize = make([]uint32, len(timeline))
+	)
+	for tick, frame := range timeline {
+		if tick == 0 || timeline[tick-1] != frame {
+			chunkSize[tick] = uint32(r.frames[frame].size)
+		}
+		moviSize += 8 + chunkSize[tick] + chunkSize[tick]&1
+		maxFrame = max(maxFrame, chunkSize[tick])
+	}
+	var (
+		frames    = uint32(len(timeline))
+		fps       = uint32(r.opts.FPS)
+		indexSize = 16 * frames
+		riffSize  = 4 + (8 + aviHeaderListSize) + (8 + moviSize) + (8 + indexSize)
+	)
+	a.write("RIFF", riffSize, "AVI ")
+	a.write("LIST", uint32(aviHeaderListSize), "hdrl")
+	a.write("avih", uint32(56),
+		uint32(1000000/fps),     // microseconds per frame
+		maxFrame*fps,            // max bytes per second
+		uint32(0),               // padding granularity
+		uint32(aviFlagHasIndex), // flags
+		frames,                  // total frames
+		uint32(0),               // initial frames
+		uint32(1),               // streams
+		maxFrame,                // suggested buffer size
+		uint32(width),           // width
+		uint32(height),          // height
+		[4]uint32{},             // reserved
+	)
+	a.write("LIST", uint32(4+(8+56)+(8+40)), "strl")
+	a.write("strh", uint32(56), "vids", "MJPG",
+		uint32(0),          // flags
+		uint16(0),          // priority
+		uint16(0),          // language
+		uint32(0),          // initial frames
+		uint32(1),          // scale
+		fps,                // rate
+		uint32(0),          // start
+		frames,             // length
+		maxFrame,           // suggested buffer size
+		uint32(0xFFFFFFFF), // default quality
+		uint32(0),          // sample size
+		[4]int16{0, 0, int16(width), int16(height)},
+	)
+	a.write("strf", uint32(40),
+		uint32(40),             // header size
+		int32(width),           // width
+		int32(height),          // height
+		uint16(1),              // planes
+		uint16(24),             // bit count
+		"MJPG",                 // compression
+		uint32(width*height*3), // image size
+		[4]uint32{},            // pixels per meter and colors
+	)
+	a.write("LIST", moviSize, "movi")
+	for tick, frame := range timeline {
+		a.write("00dc", chunkSize[tick])
+		if chunkSize[tick] == 0 {
+			continue
+		}
+		data, err := r.read(frame)
+		if err != nil {
+			return err
+		}
+		a.write(data)
+		if len(data)&1 == 1 {
+			a.write([]byte{0})
+		}
+	}
+	a.write("idx1", indexSize)
+	// offsets are relative to "movi" of the list
+	offset := uint32(4)
+	for tick := range timeline {
+		var flags uint32
+		if chunkSize[tick] > 0 {
+			flags = aviFlagKeyFrame
+		}
+		a.write("00dc", flags, offset, chunkSize[tick])
+		offset += 8 + chunkSize[tick] + chunkSize[tick]&1
+	}
+	if a.err != nil {
+		return a.err
+	}
+	return a.w.Flush()
+}
@@ -0,0 +1,106 @@
+package control
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/retrozoid/control/protocol/common"
+	"github.com/retrozoid/control/protocol/page"
+)
+
+func jpegFrame(t *testing.T, width, height int, c color.Color) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Rect, image.NewUniform(c), image.Point{}, draw.Src)
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestRecordingFitsFramesOfOtherSize(t *testing.T) {
+	spool, err := os.CreateTemp(t.TempDir(), "spool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer spool.Close()
+	var out bytes.Buffer
+	r := &recording{w: &out, opts: RecordingOptions{FPS: 10}, spool: spool}
+	white := color.RGBA{255, 255, 255, 255}
+	for n, size := range []image.Point{{40, 30}, {80, 30}, {20, 30}} {
+		frame := page.ScreencastFrame{
+			Data:     jpegFrame(t, size.X, size.Y, white),
+			Metadata: &page.ScreencastFrameMetadata{Timestamp: common.TimeSinceEpoch(1000 + float64(n)*0.1)},
+		}
+		if err = r.add(frame); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = r.encode(time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	// every frame chunk of the movie is a JPEG of the first frame size
+	var (
+		data   = out.Bytes()
+		frames int
+	)
+	for i := bytes.Index(data, []byte("movi")) + 4; i+8 <= len(data) && string(data[i:i+4]) == "00dc"; {
+		size := int(binary.LittleEndian.Uint32(data[i+4:]))
+		if size > 0 {
+			config, err := jpeg.DecodeConfig(bytes.NewReader(data[i+8 : i+8+size]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if config.Width != 40 || config.Height != 30 {
+				t.Errorf("frame %d is %dx%d", frames, config.Width, config.Height)
+			}
+			frames++
+		}
+		i += 8 + size + size&1
+	}
+	if frames != 3 {
+		t.Errorf("%d frames written, 3 expected", frames)
+	}
+}
+
+func TestLetterbox(t *testing.T) {
+	white := color.RGBA{255, 255, 255, 255}
+	for _, test := range []struct {
+		name  string
+		src   image.Point
+		white []image.Point
+		black []image.Point
+	}{
+		{"same", image.Pt(40, 30), []image.Point{{0, 0}, {39, 29}}, nil},
+		{"wider", image.Pt(80, 30), []image.Point{{0, 7}, {39, 21}}, []image.Point{{0, 6}, {39, 22}}},
+		{"narrower", image.Pt(20, 30), []image.Point{{10, 0}, {29, 29}}, []image.Point{{9, 0}, {30, 29}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			src := image.NewRGBA(image.Rect(0, 0, test.src.X, test.src.Y))
+			draw.Draw(src, src.Rect, image.NewUniform(white), image.Point{}, draw.Src)
+			img := letterbox(src, 40, 30)
+			if img.Rect.Dx() != 40 || img.Rect.Dy() != 30 {
+				t.Fatalf("letterboxed to %v", img.Rect)
+			}
+			for _, p := range test.white {
+				if img.RGBAAt(p.X, p.Y) != white {
+					t.Errorf("%v isn't the image: %v", p, img.RGBAAt(p.X, p.Y))
+				}
+			}
+			for _, p := range test.black {
+				if img.RGBAAt(p.X, p.Y) != (color.RGBA{0, 0, 0, 255}) {
+					t.Errorf("%v isn't the box: %v", p, img.RGBAAt(p.X, p.Y))
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package retry
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -19,6 +20,12 @@ type Timing interface {
 	Before(retry int)
 }
 
+// Waiter is the timing which reports the delay before the retry instead of sleeping in Before,
+// FuncContext interrupts the delay when the context is done
+type Waiter interface {
+	Wait(retry int) time.Duration
+}
+
 type Static struct {
 	Timeout time.Duration
 	Delay   time.Duration
@@ -29,9 +36,14 @@ func (d Static) GetTimeout() time.Duration {
 }
 
 func (d Static) Before(retry int) {
+	time.Sleep(d.Wait(retry))
+}
+
+func (d Static) Wait(retry int) time.Duration {
 	if retry > 0 {
-		time.Sleep(d.Delay)
+		return d.Delay
 	}
+	return 0
 }
 
 type Backoff struct {
@@ -45,9 +57,13 @@ func (d Backoff) GetTimeout() time.Duration {
 // 0 = 0s, 1 = 1s, 2 = 2s, 3 = 4s, 4 = 8s, 5 = 17s,
 // 6 = 32s, 7 = 1m5s, 8 = 2m9s, 9 = 4m23s, 10 = 8m58s
 func (d Backoff) Before(retry int) {
+	time.Sleep(d.Wait(retry))
+}
+
+func (d Backoff) Wait(retry int) time.Duration {
 	backoff := float64(uint(1) << (uint(retry) - 1))
 	backoff += backoff * (0.1 * rand.Float64())
-	time.Sleep(time.Second * time.Duration(backoff))
+	return time.Second * time.Duration(backoff)
 }
 
 func RecoverFunc(function func()) func() error {
@@ -75,6 +91,46 @@ func Func(t Timing, function func() error) error {
 	return BaseRerty(t, function)
 }
 
+// FuncContext retries the function until it succeeds, the timing runs out or the context is done
+func FuncContext(ctx context.Context, t Timing, function func() error) error {
+	var (
+		err      error
+		retry    = 0
+		start    = time.Now()
+		deadline = t.GetTimeout()
+	)
+	for time.Since(start) < deadline {
+		if waitErr := wait(ctx, t, retry); waitErr != nil {
+			return errors.Join(err, waitErr)
+		}
+		if err = function(); err == nil {
+			return nil
+		}
+		retry++
+	}
+	return err
+}
+
+// wait sleeps before the retry until the context is done
+func wait(ctx context.Context, t Timing, retry int) error {
+	var done = make(chan struct{})
+	if waiter, ok := t.(Waiter); ok {
+		timer := time.AfterFunc(waiter.Wait(retry), func() { close(done) })
+		defer timer.Stop()
+	} else {
+		go func() {
+			t.Before(retry)
+			close(done)
+		}()
+	}
+	select {
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	case <-done:
+		return nil
+	}
+}
+
 func BaseRerty(t Timing, function func() error) error {
 	var (
 		err      error
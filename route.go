@@ -0,0 +1,411 @@
+package control
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/retrozoid/control/cdp"
+	"github.com/retrozoid/control/protocol/common"
+	"github.com/retrozoid/control/protocol/fetch"
+	"github.com/retrozoid/control/protocol/network"
+)
+
+var ErrRequestAlreadyHandled = errors.New("intercepted request is already handled")
+
+// URLMatcher matches the URL of an intercepted request, *regexp.Regexp satisfies it
+type URLMatcher interface {
+	MatchString(string) bool
+}
+
+// Glob makes URLMatcher from the glob pattern:
+// `**` matches any sequence of characters, `*` matches any sequence of characters except `/`, `?` matches one character
+func Glob(pattern string) URLMatcher {
+	var expr strings.Builder
+	expr.WriteByte('^')
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				expr.WriteString(".*")
+				i++
+			} else {
+				expr.WriteString("[^/]*")
+			}
+		case '?':
+			expr.WriteByte('.')
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteByte('$')
+	return regexp.MustCompile(expr.String())
+}
+
+type RouteHandler func(*InterceptedRequest)
+
+type route struct {
+	matcher       URLMatcher
+	resourceTypes []network.ResourceType
+	handler       RouteHandler
+}
+
+func (r route) match(paused fetch.RequestPaused) bool {
+	if len(r.resourceTypes) > 0 {
+		var found bool
+		for _, t := range r.resourceTypes {
+			if t == paused.ResourceType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return r.matcher.MatchString(paused.Request.Url)
+}
+
+type router struct {
+	mutex     sync.Mutex
+	routes    []*route
+	responses map[fetch.RequestId]chan fetch.RequestPaused
+	cancel    func()
+}
+
+func newRouter() *router {
+	return &router{
+		responses: map[fetch.RequestId]chan fetch.RequestPaused{},
+	}
+}
+
+// Route intercepts requests which URL matches the matcher and passes them to the handler.
+// The latest added route takes precedence. Requests left unhandled by the handler are continued as is.
+// Fetch domain is enabled with the first route and disabled when the last one is removed
+func (s *Session) Route(matcher URLMatcher, handler RouteHandler, resourceTypes ...network.ResourceType) (unroute func() error, err error) {
+	s.router.mutex.Lock()
+	defer s.router.mutex.Unlock()
+	if len(s.router.routes) == 0 {
+		// paused request which is missed hangs forever
+		channel, cancel := s.subscribeAll("Fetch.*")
+		if err = fetch.Enable(s, fetch.EnableArgs{Patterns: []*fetch.RequestPattern{{UrlPattern: "*"}}}); err != nil {
+			cancel()
+			return nil, err
+		}
+		s.router.cancel = cancel
+		go s.dispatchRoutes(channel)
+	}
+	value := &route{
+		matcher:       matcher,
+		resourceTypes: resourceTypes,
+		handler:       handler,
+	}
+	s.router.routes = append(s.router.routes, value)
+	return func() error { return s.unroute(value) }, nil
+}
+
+func (s *Session) MustRoute(matcher URLMatcher, handler RouteHandler, resourceTypes ...network.ResourceType) func() error {
+	unroute, err := s.Route(matcher, handler, resourceTypes...)
+	if err != nil {
+		panic(err)
+	}
+	return unroute
+}
+
+func (s *Session) unroute(value *route) error {
+	s.router.mutex.Lock()
+	defer s.router.mutex.Unlock()
+	for n, r := range s.router.routes {
+		if r == value {
+			s.router.routes = append(s.router.routes[:n], s.router.routes[n+1:]...)
+			if len(s.router.routes) == 0 {
+				s.router.cancel()
+				return fetch.Disable(s)
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+func (s *Session) lookupRoute(paused fetch.RequestPaused) *route {
+	s.router.mutex.Lock()
+	defer s.router.mutex.Unlock()
+	for n := len(s.router.routes) - 1; n >= 0; n-- {
+		if s.router.routes[n].match(paused) {
+			return s.router.routes[n]
+		}
+	}
+	return nil
+}
+
+func (s *Session) dispatchRoutes(channel chan cdp.Message) {
+	for message := range channel {
+		if message.Method != "Fetch.requestPaused" {
+			continue
+		}
+		paused := mustUnmarshal[fetch.RequestPaused](message)
+		if paused.ResponseStatusCode != 0 || paused.ResponseErrorReason != "" {
+			s.router.mutex.Lock()
+			waiter, ok := s.router.responses[paused.RequestId]
+			delete(s.router.responses, paused.RequestId)
+			s.router.mutex.Unlock()
+			if ok {
+				waiter <- paused
+				continue
+			}
+			go s.continuePaused(paused.RequestId)
+			continue
+		}
+		value := s.lookupRoute(paused)
+		if value == nil {
+			go s.continuePaused(paused.RequestId)
+			continue
+		}
+		go s.runRoute(value, &InterceptedRequest{session: s, paused: paused})
+	}
+}
+
+func (s *Session) continuePaused(id fetch.RequestId) {
+	if err := fetch.ContinueRequest(s, fetch.ContinueRequestArgs{RequestId: id}); err != nil {
+		s.Log("can't continue intercepted request", "requestId", id, "err", err)
+	}
+}
+
+func (s *Session) runRoute(value *route, request *InterceptedRequest) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.Log("route handler panic", "url", request.URL(), "err", fmt.Sprint(r))
+		}
+		switch {
+		case !request.handled:
+			s.continuePaused(request.paused.RequestId)
+		case request.response != nil && !request.response.handled:
+			s.continuePaused(request.response.paused.RequestId)
+		}
+	}()
+	value.handler(request)
+}
+
+// ContinueOverrides are the request parameters to change before the request is sent, zero values keep the original ones
+type ContinueOverrides struct {
+	URL      string
+	Method   string
+	Headers  map[string]string
+	PostData []byte
+}
+
+// FulfillResponse is the response to provide instead of performing the request
+type FulfillResponse struct {
+	Status  int
+	Phrase  string
+	Headers map[string]string
+	Body    []byte
+}
+
+type InterceptedRequest struct {
+	session  *Session
+	paused   fetch.RequestPaused
+	handled  bool
+	response *InterceptedResponse
+}
+
+func (r *InterceptedRequest) Event() fetch.RequestPaused {
+	return r.paused
+}
+
+func (r *InterceptedRequest) URL() string {
+	return r.paused.Request.Url
+}
+
+func (r *InterceptedRequest) Method() string {
+	return r.paused.Request.Method
+}
+
+func (r *InterceptedRequest) Headers() map[string]string {
+	return headersToMap(r.paused.Request.Headers)
+}
+
+func (r *InterceptedRequest) PostData() string {
+	return r.paused.Request.PostData
+}
+
+func (r *InterceptedRequest) ResourceType() network.ResourceType {
+	return r.paused.ResourceType
+}
+
+func (r *InterceptedRequest) FrameID() common.FrameId {
+	return r.paused.FrameId
+}
+
+func (r *InterceptedRequest) handle() error {
+	if r.handled {
+		return ErrRequestAlreadyHandled
+	}
+	r.handled = true
+	return nil
+}
+
+func (r *InterceptedRequest) continueArgs(overrides ContinueOverrides) fetch.ContinueRequestArgs {
+	return fetch.ContinueRequestArgs{
+		RequestId: r.paused.RequestId,
+		Url:       overrides.URL,
+		Method:    overrides.Method,
+		PostData:  overrides.PostData,
+		Headers:   toHeaderEntries(overrides.Headers),
+	}
+}
+
+func (r *InterceptedRequest) Continue(overrides ContinueOverrides) error {
+	if err := r.handle(); err != nil {
+		return err
+	}
+	return fetch.ContinueRequest(r.session, r.continueArgs(overrides))
+}
+
+func (r *InterceptedRequest) Fulfill(response FulfillResponse) error {
+	if err := r.handle(); err != nil {
+		return err
+	}
+	return fulfill(r.session, r.paused.RequestId, response)
+}
+
+func (r *InterceptedRequest) Fail(reason network.ErrorReason) error {
+	if err := r.handle(); err != nil {
+		return err
+	}
+	return fetch.FailRequest(r.session, fetch.FailRequestArgs{
+		RequestId:   r.paused.RequestId,
+		ErrorReason: reason,
+	})
+}
+
+// Fetch sends the request and pauses it again when the response is received,
+// the response has to be continued or fulfilled then
+func (r *InterceptedRequest) Fetch(overrides ContinueOverrides) (*InterceptedResponse, error) {
+	if err := r.handle(); err != nil {
+		return nil, err
+	}
+	waiter := make(chan fetch.RequestPaused, 1)
+	r.session.router.mutex.Lock()
+	r.session.router.responses[r.paused.RequestId] = waiter
+	r.session.router.mutex.Unlock()
+	defer func() {
+		r.session.router.mutex.Lock()
+		delete(r.session.router.responses, r.paused.RequestId)
+		r.session.router.mutex.Unlock()
+	}()
+
+	args := r.continueArgs(overrides)
+	args.InterceptResponse = true
+	if err := fetch.ContinueRequest(r.session, args); err != nil {
+		return nil, err
+	}
+	ctx, cancel := r.session.withTimeout(r.session.Timeouts().orDefault(0))
+	defer cancel()
+	select {
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	case paused := <-waiter:
+		r.response = &InterceptedResponse{session: r.session, paused: paused}
+		return r.response, nil
+	}
+}
+
+type InterceptedResponse struct {
+	session *Session
+	paused  fetch.RequestPaused
+	handled bool
+}
+
+func (r *InterceptedResponse) Event() fetch.RequestPaused {
+	return r.paused
+}
+
+func (r *InterceptedResponse) Status() int {
+	return r.paused.ResponseStatusCode
+}
+
+func (r *InterceptedResponse) StatusText() string {
+	return r.paused.ResponseStatusText
+}
+
+func (r *InterceptedResponse) ErrorReason() network.ErrorReason {
+	return r.paused.ResponseErrorReason
+}
+
+func (r *InterceptedResponse) Headers() map[string]string {
+	return fromHeaderEntries(r.paused.ResponseHeaders)
+}
+
+func (r *InterceptedResponse) Body() ([]byte, error) {
+	val, err := fetch.GetResponseBody(r.session, fetch.GetResponseBodyArgs{RequestId: r.paused.RequestId})
+	if err != nil {
+		return nil, err
+	}
+	if val.Base64Encoded {
+		return base64.StdEncoding.DecodeString(val.Body)
+	}
+	return []byte(val.Body), nil
+}
+
+func (r *InterceptedResponse) Continue() error {
+	if r.handled {
+		return ErrRequestAlreadyHandled
+	}
+	r.handled = true
+	return fetch.ContinueRequest(r.session, fetch.ContinueRequestArgs{RequestId: r.paused.RequestId})
+}
+
+func (r *InterceptedResponse) Fulfill(response FulfillResponse) error {
+	if r.handled {
+		return ErrRequestAlreadyHandled
+	}
+	r.handled = true
+	return fulfill(r.session, r.paused.RequestId, response)
+}
+
+func fulfill(s *Session, id fetch.RequestId, response FulfillResponse) error {
+	if response.Status == 0 {
+		response.Status = 200
+	}
+	return fetch.FulfillRequest(s, fetch.FulfillRequestArgs{
+		RequestId:       id,
+		ResponseCode:    response.Status,
+		ResponsePhrase:  response.Phrase,
+		ResponseHeaders: toHeaderEntries(response.Headers),
+		Body:            response.Body,
+	})
+}
+
+func toHeaderEntries(headers map[string]string) []*fetch.HeaderEntry {
+	if len(headers) == 0 {
+		return nil
+	}
+	entries := make([]*fetch.HeaderEntry, 0, len(headers))
+	for name, value := range headers {
+		// multiline value stands for repeated header, e.g. Set-Cookie
+		for _, line := range strings.Split(value, "\n") {
+			entries = append(entries, &fetch.HeaderEntry{Name: name, Value: line})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries
+}
+
+func fromHeaderEntries(entries []*fetch.HeaderEntry) map[string]string {
+	headers := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		if value, ok := headers[entry.Name]; ok {
+			headers[entry.Name] = value + "\n" + entry.Value
+		} else {
+			headers[entry.Name] = entry.Value
+		}
+	}
+	return headers
+}
@@ -104,7 +104,7 @@ func (f *Frame) unserialize(value *runtime.RemoteObject) (any, error) {
 
 	case "node":
 		switch getNodeType(value.DeepSerializedValue.Value) {
-		case nodeTypeElement, nodeTypeDocument:
+		case nodeTypeElement, nodeTypeDocument, nodeTypeFragment:
 			return &Node{
 				object: remoteObjectValue(value.ObjectId),
 				frame:  f,
@@ -170,7 +170,7 @@ func (f Frame) evaluate(expression string, awaitPromise bool) (any, error) {
 		IncludeCommandLineAPI: true,
 		UniqueContextId:       uid,
 		AwaitPromise:          awaitPromise,
-		Timeout:               runtime.TimeDelta(f.session.timeout.Milliseconds()),
+		Timeout:               runtime.TimeDelta(f.session.Timeouts().evaluation().Milliseconds()),
 		SerializationOptions: &runtime.SerializationOptions{
 			Serialization: "deep",
 		},
@@ -0,0 +1,243 @@
+package control
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"math"
+
+	"github.com/retrozoid/control/protocol/dom"
+	"github.com/retrozoid/control/protocol/emulation"
+	"github.com/retrozoid/control/protocol/page"
+)
+
+// DefaultMaskColor paints over the masked elements
+var DefaultMaskColor = "#FF00FF"
+
+type ScreenshotOptions struct {
+	// Format is png, jpeg or webp, png if it's empty
+	Format string
+	// Quality of jpeg and webp in range 0..100
+	Quality int
+	// FullPage captures the whole scrollable page instead of the viewport
+	FullPage bool
+	// Resize captures the full page by resizing the viewport to the size of the page instead of captureBeyondViewport,
+	// it lays out fixed and viewport sized elements for the whole page
+	Resize bool
+	// Clip is the area of the page in CSS pixels relative to the document
+	Clip *dom.Rect
+	// Mask paints over the elements found by CSS selectors, e.g. timestamps and ads
+	Mask []string
+	// MaskColor is CSS color of the mask, DefaultMaskColor if it's empty
+	MaskColor string
+	// HideCaret makes the text caret transparent
+	HideCaret bool
+	// OmitBackground makes the default white background transparent, png and webp only
+	OmitBackground bool
+}
+
+// Screenshot captures the viewport, the full page or the clip of the page
+func (s *Session) Screenshot(opts ScreenshotOptions) ([]byte, error) {
+	return s.screenshot(opts, nil)
+}
+
+func (s *Session) MustScreenshot(opts ScreenshotOptions) []byte {
+	b, err := s.Screenshot(opts)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// ScreenshotImage captures the screenshot and decodes it, webp can't be decoded
+func (s *Session) ScreenshotImage(opts ScreenshotOptions) (image.Image, error) {
+	return decodeScreenshot(s.Screenshot(opts))
+}
+
+func (s *Session) MustScreenshotImage(opts ScreenshotOptions) image.Image {
+	img, err := s.ScreenshotImage(opts)
+	if err != nil {
+		panic(err)
+	}
+	return img
+}
+
+// Screenshot captures the box of the element scrolled into view, FullPage and Clip options are ignored
+func (e Node) Screenshot(opts ScreenshotOptions) ([]byte, error) {
+	if err := e.scrollIntoView(); err != nil {
+		return nil, err
+	}
+	q, err := e.getContentQuad()
+	if err != nil {
+		return nil, err
+	}
+	left, top, right, bottom := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, p := range q {
+		left, top = math.Min(left, p.X), math.Min(top, p.Y)
+		right, bottom = math.Max(right, p.X), math.Max(bottom, p.Y)
+	}
+	opts.FullPage = false
+	return e.frame.session.screenshot(opts, &dom.Rect{X: left, Y: top, Width: right - left, Height: bottom - top})
+}
+
+func (e Node) MustScreenshot(opts ScreenshotOptions) []byte {
+	b, err := e.Screenshot(opts)
+	panicIfError(err)
+	return b
+}
+
+func (e Node) ScreenshotImage(opts ScreenshotOptions) (image.Image, error) {
+	return decodeScreenshot(e.Screenshot(opts))
+}
+
+func (e Node) MustScreenshotImage(opts ScreenshotOptions) image.Image {
+	img, err := e.ScreenshotImage(opts)
+	panicIfError(err)
+	return img
+}
+
+func decodeScreenshot(b []byte, err error) (image.Image, error) {
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(b))
+	return img, err
+}
+
+// transparentBackgroundArgs sends the transparent color unlike emulation.SetDefaultBackgroundColorOverrideArgs,
+// zero alpha of dom.RGBA is omitted and the browser takes the color as opaque black
+type transparentBackgroundArgs struct {
+	Color struct {
+		R int     `json:"r"`
+		G int     `json:"g"`
+		B int     `json:"b"`
+		A float64 `json:"a"`
+	} `json:"color"`
+}
+
+// screenshot captures the page, viewportClip is the area relative to the viewport
+func (s *Session) screenshot(opts ScreenshotOptions, viewportClip *dom.Rect) (b []byte, err error) {
+	args := page.CaptureScreenshotArgs{
+		Format:  opts.Format,
+		Quality: opts.Quality,
+	}
+	fullPage := viewportClip == nil && opts.Clip == nil && opts.FullPage
+	// masks are placed by the layout of the resized viewport
+	if fullPage && opts.Resize {
+		if err = s.resizeToContent(); err != nil {
+			return nil, err
+		}
+		defer func() {
+			err = errors.Join(err, s.restoreDeviceMetrics())
+		}()
+	}
+	if len(opts.Mask) > 0 || opts.HideCaret {
+		if err = s.decorate(opts); err != nil {
+			return nil, err
+		}
+		defer func() {
+			_, cleanupErr := s.Frame.evaluate(`document.querySelectorAll('[data-control-screenshot]').forEach(e => e.remove())`, false)
+			err = errors.Join(err, cleanupErr)
+		}()
+	}
+	if opts.OmitBackground {
+		if err = s.Call("Emulation.setDefaultBackgroundColorOverride", transparentBackgroundArgs{}, nil); err != nil {
+			return nil, err
+		}
+		defer func() {
+			err = errors.Join(err, emulation.SetDefaultBackgroundColorOverride(s, emulation.SetDefaultBackgroundColorOverrideArgs{}))
+		}()
+	}
+
+	switch {
+	case viewportClip != nil:
+		layout, err := page.GetLayoutMetrics(s)
+		if err != nil {
+			return nil, err
+		}
+		args.CaptureBeyondViewport = true
+		args.Clip = &page.Viewport{
+			X:      viewportClip.X + layout.CssVisualViewport.PageX,
+			Y:      viewportClip.Y + layout.CssVisualViewport.PageY,
+			Width:  viewportClip.Width,
+			Height: viewportClip.Height,
+			Scale:  1,
+		}
+	case opts.Clip != nil:
+		args.CaptureBeyondViewport = true
+		args.Clip = &page.Viewport{X: opts.Clip.X, Y: opts.Clip.Y, Width: opts.Clip.Width, Height: opts.Clip.Height, Scale: 1}
+	case fullPage && !opts.Resize:
+		layout, err := page.GetLayoutMetrics(s)
+		if err != nil {
+			return nil, err
+		}
+		args.CaptureBeyondViewport = true
+		args.Clip = &page.Viewport{Width: layout.CssContentSize.Width, Height: layout.CssContentSize.Height, Scale: 1}
+	}
+	val, err := page.CaptureScreenshot(s, args)
+	if err != nil {
+		return nil, err
+	}
+	return val.Data, nil
+}
+
+// resizeToContent overrides the viewport with the size of the page keeping the emulated device
+func (s *Session) resizeToContent() error {
+	layout, err := page.GetLayoutMetrics(s)
+	if err != nil {
+		return err
+	}
+	metrics := emulation.SetDeviceMetricsOverrideArgs{
+		Width:  layout.CssLayoutViewport.ClientWidth,
+		Height: int(math.Ceil(layout.CssContentSize.Height)),
+	}
+	if d, ok := s.Emulated(); ok {
+		metrics.DeviceScaleFactor, metrics.Mobile = d.DeviceScaleFactor, d.Mobile
+	}
+	return emulation.SetDeviceMetricsOverride(s, metrics)
+}
+
+// restoreDeviceMetrics undoes the viewport override of the full page screenshot keeping the emulated device
+func (s *Session) restoreDeviceMetrics() error {
+	if d, ok := s.Emulated(); ok {
+		return s.setDeviceMetrics(d)
+	}
+	return emulation.ClearDeviceMetricsOverride(s)
+}
+
+// decorate appends the masks and the style hiding caret to the document, they are marked to be removed after capture
+func (s *Session) decorate(opts ScreenshotOptions) error {
+	color := opts.MaskColor
+	if color == "" {
+		color = DefaultMaskColor
+	}
+	args, err := json.Marshal([]any{opts.Mask, color, opts.HideCaret})
+	if err != nil {
+		return err
+	}
+	_, err = s.Frame.evaluate(`((selectors, color, hideCaret) => {
+		const root = document.createElement('div')
+		root.setAttribute('data-control-screenshot', '')
+		root.style.cssText = 'position:absolute;left:0;top:0;width:0;height:0;overflow:visible;z-index:2147483647;pointer-events:none'
+		for (const selector of selectors || []) {
+			for (const e of document.querySelectorAll(selector)) {
+				for (const r of e.getClientRects()) {
+					const mask = document.createElement('div')
+					mask.style.cssText = 'position:absolute;left:' + (r.left + scrollX) + 'px;top:' + (r.top + scrollY) + 'px;width:' + r.width + 'px;height:' + r.height + 'px'
+					mask.style.background = color
+					root.appendChild(mask)
+				}
+			}
+		}
+		if (hideCaret) {
+			const style = document.createElement('style')
+			style.textContent = '*, *::before, *::after { caret-color: transparent !important }'
+			root.appendChild(style)
+		}
+		document.documentElement.appendChild(root)
+	})(...`+string(args)+`)`, false)
+	return err
+}
@@ -0,0 +1,83 @@
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/retrozoid/control/cdptest"
+)
+
+// withExecutionContext reports the execution context of the main frame and waits for the session to take it
+func withExecutionContext(t *testing.T, ctx context.Context, server *cdptest.Server, s *Session) {
+	t.Helper()
+	if err := server.Emit(s.GetID(), "Runtime.executionContextCreated", map[string]any{
+		"context": map[string]any{"id": 1, "uniqueId": "context-1", "auxData": map[string]any{"frameId": string(s.targetID)}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for s.Frame.executionContextID() == "" {
+		select {
+		case <-ctx.Done():
+			t.Fatal("execution context isn't created")
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestScreenshotResizeBeforeMasks(t *testing.T) {
+	server, session, ctx := newTestSession(t)
+	withExecutionContext(t, ctx, server, session)
+	server.Handle("Page.getLayoutMetrics", cdptest.Result(map[string]any{
+		"cssLayoutViewport": map[string]any{"clientWidth": 800, "clientHeight": 600},
+		"cssVisualViewport": map[string]any{},
+		"cssContentSize":    map[string]any{"width": 800, "height": 2000.5},
+	}))
+	server.Handle("Runtime.evaluate", cdptest.Result(map[string]any{"result": map[string]any{"type": "undefined"}}))
+	server.Handle("Page.captureScreenshot", cdptest.Result(map[string]any{"data": "cG5n"}))
+	server.Reset()
+
+	b, err := session.Screenshot(ScreenshotOptions{FullPage: true, Resize: true, Mask: []string{".ad"}, OmitBackground: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "png" {
+		t.Errorf("unexpected screenshot %q", b)
+	}
+
+	var methods []string
+	for _, call := range server.Calls() {
+		methods = append(methods, call.Method)
+	}
+	want := []string{
+		"Page.getLayoutMetrics",
+		"Emulation.setDeviceMetricsOverride",
+		"Runtime.evaluate",
+		"Emulation.setDefaultBackgroundColorOverride",
+		"Page.captureScreenshot",
+		"Emulation.setDefaultBackgroundColorOverride",
+		"Runtime.evaluate",
+		"Emulation.clearDeviceMetricsOverride",
+	}
+	if len(methods) != len(want) {
+		t.Fatalf("calls %q, want %q", methods, want)
+	}
+	for n := range want {
+		if methods[n] != want[n] {
+			t.Fatalf("calls %q, want %q", methods, want)
+		}
+	}
+
+	metrics := server.Calls("Emulation.setDeviceMetricsOverride")[0]
+	var size struct{ Width, Height int }
+	if err = metrics.Unmarshal(&size); err != nil {
+		t.Fatal(err)
+	}
+	if size.Width != 800 || size.Height != 2001 {
+		t.Errorf("viewport is resized to %+v", size)
+	}
+	background := server.Calls("Emulation.setDefaultBackgroundColorOverride")[0]
+	if got := string(background.Params); got != `{"color":{"r":0,"g":0,"b":0,"a":0}}` {
+		t.Errorf("background override %s isn't transparent", got)
+	}
+}
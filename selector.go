@@ -0,0 +1,304 @@
+package control
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/retrozoid/control/protocol/accessibility"
+	"github.com/retrozoid/control/protocol/dom"
+)
+
+// SelectorEngine finds the elements matching the selector body inside of the root node
+type SelectorEngine interface {
+	QueryAll(root *Node, body string) (NodeList, error)
+}
+
+type SelectorEngineFunc func(root *Node, body string) (NodeList, error)
+
+func (f SelectorEngineFunc) QueryAll(root *Node, body string) (NodeList, error) {
+	return f(root, body)
+}
+
+var (
+	selectorEnginesMutex = &sync.RWMutex{}
+	selectorEngines      = map[string]SelectorEngine{
+		"css":         SelectorEngineFunc(queryCSS),
+		"xpath":       JSSelectorEngine(`function(x){const r=[],s=(this.ownerDocument||this).evaluate(x,this,null,XPathResult.ORDERED_NODE_SNAPSHOT_TYPE,null);for(let i=0;i<s.snapshotLength;i++){const n=s.snapshotItem(i);1===n.nodeType&&r.push(n)}return r}`),
+		"text":        SelectorEngineFunc(queryText),
+		"role":        SelectorEngineFunc(queryRole),
+		"data-testid": JSSelectorEngine(`function(v){return this.querySelectorAll('[data-testid="'+CSS.escape(v)+'"]')}`),
+		"pierce":      SelectorEngineFunc(queryPierce),
+	}
+)
+
+// RegisterSelectorEngine makes the engine available for selectors prefixed with `name=`
+func RegisterSelectorEngine(name string, engine SelectorEngine) {
+	selectorEnginesMutex.Lock()
+	defer selectorEnginesMutex.Unlock()
+	selectorEngines[name] = engine
+}
+
+// RegisterJSSelectorEngine registers the engine implemented by JS function,
+// see JSSelectorEngine
+func RegisterJSSelectorEngine(name string, function string) {
+	RegisterSelectorEngine(name, JSSelectorEngine(function))
+}
+
+// JSSelectorEngine makes the engine from JS function which is called on the root node with the selector body
+// and returns an array or NodeList of the matched elements
+func JSSelectorEngine(function string) SelectorEngine {
+	return SelectorEngineFunc(func(root *Node, body string) (NodeList, error) {
+		return root.evalNodeList(function, body)
+	})
+}
+
+func lookupSelectorEngine(name string) (SelectorEngine, bool) {
+	selectorEnginesMutex.RLock()
+	defer selectorEnginesMutex.RUnlock()
+	engine, ok := selectorEngines[name]
+	return engine, ok
+}
+
+type selectorPart struct {
+	engine string
+	body   string
+	pierce bool
+}
+
+var selectorEnginePrefix = regexp.MustCompile(`^([a-zA-Z][\w-]*)=`)
+
+// parseSelector splits the selector chain `part >> part` and detects the engine of every part,
+// a part without known `engine=` prefix is CSS, `//` starts XPath and quoted string is text.
+// The part after `>>>` combinator is also looked for inside of open shadow roots
+func parseSelector(selector string) ([]selectorPart, error) {
+	var parts []selectorPart
+	values, pierce := splitSelector(selector)
+	for n, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, fmt.Errorf("invalid selector `%s`: empty part", selector)
+		}
+		part := selectorPart{engine: "css", body: value, pierce: pierce[n]}
+		if m := selectorEnginePrefix.FindStringSubmatch(value); m != nil {
+			if _, ok := lookupSelectorEngine(m[1]); ok {
+				part.engine, part.body = m[1], value[len(m[0]):]
+			}
+		} else if strings.HasPrefix(value, "//") || strings.HasPrefix(value, "..") {
+			part.engine = "xpath"
+		} else if strings.HasPrefix(value, `"`) {
+			part.engine = "text"
+		}
+		parts = append(parts, part)
+	}
+	return parts, nil
+}
+
+// splitSelector splits by `>>` and `>>>` outside of quotes and brackets,
+// pierce flags the parts following `>>>`. Quote starts a quoted value only at the start of the part
+// or after one of `=([,` and spaces, apostrophe in the text like `text=Don't` is a part of the text
+func splitSelector(selector string) (parts []string, pierce []bool) {
+	var (
+		quote  byte
+		depth  int
+		start  int
+		shadow bool
+	)
+	if trimmed := strings.TrimLeft(selector, " "); strings.HasPrefix(trimmed, ">>>") {
+		selector, shadow = trimmed[3:], true
+	}
+	for i := 0; i < len(selector); i++ {
+		c := selector[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case (c == '"' || c == '\'') && opensQuote(selector[start:i]):
+			quote = c
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+		case depth == 0 && strings.HasPrefix(selector[i:], ">>"):
+			parts = append(parts, selector[start:i])
+			pierce = append(pierce, shadow)
+			shadow = strings.HasPrefix(selector[i:], ">>>")
+			if shadow {
+				i++
+			}
+			i++
+			start = i + 1
+		}
+	}
+	return append(parts, selector[start:]), append(pierce, shadow)
+}
+
+// opensQuote reports whether a quote following the prefix of the part starts a quoted value
+func opensQuote(prefix string) bool {
+	return prefix == "" || strings.ContainsAny(prefix[len(prefix)-1:], "=([, ")
+}
+
+func isPlainCSS(parts []selectorPart) bool {
+	return len(parts) == 1 && parts[0].engine == "css" && !parts[0].pierce
+}
+
+func (e Node) queryAll(selector string) (NodeList, error) {
+	parts, err := parseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	nodes := NodeList{&e}
+	for _, part := range parts {
+		engine, _ := lookupSelectorEngine(part.engine)
+		next := NodeList{}
+		roots := nodes
+		if part.pierce {
+			roots = NodeList{}
+			for _, node := range nodes {
+				value, err := node.shadowRoots()
+				if err != nil {
+					return nil, err
+				}
+				roots = append(roots, value...)
+			}
+		}
+		for _, root := range roots {
+			found, err := engine.QueryAll(root, part.body)
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, found...)
+		}
+		if part.pierce && len(nodes) > 1 {
+			// shadow roots nested in several matched hosts are searched more than once
+			if next, err = uniqueNodes(next); err != nil {
+				return nil, err
+			}
+		}
+		if len(next) == 0 {
+			return nil, NoSuchSelectorError(selector)
+		}
+		nodes = next
+	}
+	return nodes, nil
+}
+
+// uniqueNodes drops the repeated nodes keeping the order of the first ones
+func uniqueNodes(nodes NodeList) (NodeList, error) {
+	var (
+		seen   = map[dom.BackendNodeId]struct{}{}
+		unique = NodeList{}
+	)
+	for _, node := range nodes {
+		value, err := node.frame.describeNode(node)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[value.BackendNodeId]; ok {
+			continue
+		}
+		seen[value.BackendNodeId] = struct{}{}
+		unique = append(unique, node)
+	}
+	return unique, nil
+}
+
+func queryCSS(root *Node, body string) (NodeList, error) {
+	value, err := root.eval(`function(s){return this.querySelectorAll(s)}`, body)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return value.(NodeList), nil
+}
+
+// queryPierce matches CSS selector inside of the root and all its open shadow roots
+func queryPierce(root *Node, body string) (NodeList, error) {
+	roots, err := root.shadowRoots()
+	if err != nil {
+		return nil, err
+	}
+	nodes := NodeList{}
+	for _, value := range roots {
+		found, err := queryCSS(value, body)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, found...)
+	}
+	return nodes, nil
+}
+
+// queryText matches the deepest elements which text contains the body case-insensitively,
+// quoted body matches the whole text exactly. Whitespaces are normalized
+func queryText(root *Node, body string) (NodeList, error) {
+	var exact bool
+	if strings.HasPrefix(body, `"`) {
+		value, err := strconv.Unquote(body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid text selector `%s`: %w", body, err)
+		}
+		body, exact = value, true
+	}
+	return root.evalNodeList(`function(t,x){
+		const n = s => s.replace(/\s+/g, ' ').trim(),
+			q = x ? n(t) : n(t).toLowerCase(),
+			m = e => {
+				if (['SCRIPT', 'STYLE', 'NOSCRIPT', 'TEMPLATE'].includes(e.nodeName)) return !1
+				const v = n(('INPUT' === e.nodeName && ['button', 'submit'].includes(e.type)) ? e.value : (e.innerText ?? e.textContent ?? ''))
+				return x ? v === q : v.toLowerCase().includes(q)
+			},
+			r = [],
+			w = (this.ownerDocument || this).createTreeWalker(this, NodeFilter.SHOW_ELEMENT)
+		for (let e = w.nextNode(); e; e = w.nextNode()) {
+			if (m(e) && !Array.from(e.children).some(m)) r.push(e)
+		}
+		return r
+	}`, body, exact)
+}
+
+var roleSelector = regexp.MustCompile(`^\s*([\w-]+)\s*(?:\[\s*name\s*=\s*("(?:[^"\\]|\\.)*"|[^\]]*?)\s*\])?\s*$`)
+
+// queryRole matches the elements by ARIA role and accessible name as assistive technology sees them
+func queryRole(root *Node, body string) (NodeList, error) {
+	m := roleSelector.FindStringSubmatch(body)
+	if m == nil {
+		return nil, fmt.Errorf("invalid role selector `%s`", body)
+	}
+	role, name := m[1], m[2]
+	if strings.HasPrefix(name, `"`) {
+		value, err := strconv.Unquote(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid role selector `%s`: %w", body, err)
+		}
+		name = value
+	}
+	val, err := accessibility.QueryAXTree(root, accessibility.QueryAXTreeArgs{
+		ObjectId:       root.GetRemoteObjectID(),
+		Role:           role,
+		AccessibleName: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodes := NodeList{}
+	for _, axNode := range val.Nodes {
+		if axNode.Ignored || axNode.BackendDOMNodeId == 0 {
+			continue
+		}
+		resolved, err := dom.ResolveNode(root, dom.ResolveNodeArgs{BackendNodeId: axNode.BackendDOMNodeId})
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, &Node{
+			object:            remoteObjectValue(resolved.Object.ObjectId),
+			requestedSelector: "role=" + body,
+			frame:             root.frame,
+		})
+	}
+	return nodes, nil
+}
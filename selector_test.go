@@ -0,0 +1,32 @@
+package control
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitSelector(t *testing.T) {
+	for _, test := range []struct {
+		selector string
+		parts    []string
+		pierce   []bool
+	}{
+		{"div", []string{"div"}, []bool{false}},
+		{"div >> span", []string{"div ", " span"}, []bool{false, false}},
+		{"div >>> span", []string{"div ", " span"}, []bool{false, true}},
+		{">>> span", []string{" span"}, []bool{true}},
+		{`text="a >> b" >> css=button`, []string{`text="a >> b" `, " css=button"}, []bool{false, false}},
+		{`text=Don't >> css=button`, []string{"text=Don't ", " css=button"}, []bool{false, false}},
+		{`text="Don't >> stop" >> b`, []string{`text="Don't >> stop" `, " b"}, []bool{false, false}},
+		{`[title='a >> b'] >> b`, []string{`[title='a >> b'] `, " b"}, []bool{false, false}},
+		{`//a[contains(., 'x >> y')] >>> b`, []string{`//a[contains(., 'x >> y')] `, " b"}, []bool{false, true}},
+		{`'quoted >> text' >> b`, []string{`'quoted >> text' `, " b"}, []bool{false, false}},
+	} {
+		t.Run(test.selector, func(t *testing.T) {
+			parts, pierce := splitSelector(test.selector)
+			if !reflect.DeepEqual(parts, test.parts) || !reflect.DeepEqual(pierce, test.pierce) {
+				t.Errorf("splitSelector() = %q %v, want %q %v", parts, pierce, test.parts, test.pierce)
+			}
+		})
+	}
+}
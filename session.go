@@ -49,17 +49,31 @@ func mustUnmarshal[T any](u cdp.Message) T {
 }
 
 type Session struct {
-	timeout          time.Duration
-	context          context.Context
-	transport        *cdp.Transport
-	targetID         target.TargetID
-	sessionID        string
-	frames           *sync.Map
-	Frame            *Frame
+	timeouts  *timeoutsState
+	context   context.Context
+	caller    context.Context
+	transport *cdp.Transport
+	targetID  target.TargetID
+	sessionID string
+	frames    *sync.Map
+	inflight  *inflightRequests
+	router    *router
+	state     *sessionState
+	Frame     *Frame
+	mouse     Mouse
+	kb        Keyboard
+	touch     Touch
+}
+
+// sessionState is shared by the session and its views
+type sessionState struct {
+	network          *Network
+	networkOnce      sync.Once
 	highlightEnabled bool
-	mouse            Mouse
-	kb               Keyboard
-	touch            Touch
+	listenerErrors   listenerErrors
+	recording        recordingState
+	emulation        emulationState
+	detachOnClose    bool
 }
 
 func (s *Session) Transport() *cdp.Transport {
@@ -99,19 +113,26 @@ func (s *Session) IsDone() bool {
 }
 
 func (s *Session) Call(method string, send, recv any) error {
+	return s.call(s.sessionID, method, send, recv)
+}
+
+func (s *Session) call(sessionID string, method string, send, recv any) error {
 	select {
 	case <-s.context.Done():
 		return context.Cause(s.context)
 	default:
 	}
+	if err := s.callerDone(); err != nil {
+		return err
+	}
 	future := s.transport.Send(&cdp.Request{
-		SessionID: string(s.sessionID),
+		SessionID: sessionID,
 		Method:    method,
 		Params:    send,
 	})
 	defer future.Cancel()
 
-	ctxTo, cancel := context.WithTimeout(s.context, s.timeout)
+	ctxTo, cancel := s.withTimeout(s.Timeouts().method(method))
 	defer cancel()
 	value, err := future.Get(ctxTo)
 	if err != nil {
@@ -128,12 +149,43 @@ func (s *Session) Subscribe() (channel chan cdp.Message, cancel func()) {
 	return s.transport.Subscribe(s.sessionID)
 }
 
+// SubscribeWithOptions subscribes to the messages of the session filtered by the broker
+func (s *Session) SubscribeWithOptions(options cdp.SubscribeOptions) *cdp.Subscription {
+	options.SessionID = s.sessionID
+	return s.transport.SubscribeWithOptions(options)
+}
+
+// waiterBufferSize is the buffer of internal subscriptions waiting for an event
+const waiterBufferSize = 64
+
+// subscribe returns the channel of the methods or domains like "Network.*" for waiting of an event,
+// it drops the oldest messages instead of blocking the transport when the waiter falls behind
+func (s *Session) subscribe(methods ...string) (channel chan cdp.Message, cancel func()) {
+	subscription := s.SubscribeWithOptions(cdp.SubscribeOptions{Methods: methods, Size: waiterBufferSize})
+	return subscription.C(), subscription.Cancel
+}
+
+// subscribeAll returns the channel of every message of the methods, the transport is blocked until it's read.
+// It's for the internal loops which must not miss a message and never wait for the user code
+func (s *Session) subscribeAll(methods ...string) (channel chan cdp.Message, cancel func()) {
+	subscription := s.SubscribeWithOptions(cdp.SubscribeOptions{Methods: methods, Overflow: cdp.OverflowBlock})
+	return subscription.C(), subscription.Cancel
+}
+
 func NewSession(transport *cdp.Transport, targetID target.TargetID) (*Session, error) {
+	return newSession(transport, targetID, nil, DefaultTimeouts)
+}
+
+// newSession attaches to the target with the timeouts, the calls of attaching are cancelled with the caller context as well
+func newSession(transport *cdp.Transport, targetID target.TargetID, caller context.Context, timeouts Timeouts) (*Session, error) {
 	var session = &Session{
 		transport: transport,
 		targetID:  targetID,
-		timeout:   60 * time.Second,
+		timeouts:  newTimeoutsState(timeouts),
 		frames:    &sync.Map{},
+		inflight:  newInflightRequests(),
+		router:    newRouter(),
+		state:     &sessionState{},
 	}
 	session.mouse = NewMouse(session)
 	session.kb = NewKeyboard(session)
@@ -144,50 +196,66 @@ func NewSession(transport *cdp.Transport, targetID target.TargetID) (*Session, e
 	}
 	var cancel func(error)
 	session.context, cancel = context.WithCancelCause(transport.Context())
-	val, err := target.AttachToTarget(session, target.AttachToTargetArgs{
+	bound := session
+	if caller != nil {
+		bound = session.WithContext(caller)
+	}
+	val, err := target.AttachToTarget(bound, target.AttachToTargetArgs{
 		TargetId: targetID,
 		Flatten:  true,
 	})
 	if err != nil {
+		cancel(err)
 		return nil, err
 	}
 	session.sessionID = string(val.SessionId)
-	channel, unsubscribe := session.Subscribe()
+	channel, unsubscribe := session.subscribeAll()
 	go func() {
 		if err := session.handle(channel); err != nil {
 			unsubscribe()
 			cancel(err)
 		}
 	}()
-	if err = page.Enable(session); err != nil {
-		return nil, err
+	if caller != nil {
+		bound = session.WithContext(caller)
 	}
-	if err = page.SetLifecycleEventsEnabled(session, page.SetLifecycleEventsEnabledArgs{Enabled: true}); err != nil {
+	if err = bound.enable(); err != nil {
+		// stops the event loop, nobody else is going to close the session
+		unsubscribe()
+		cancel(err)
 		return nil, err
 	}
-	if err = runtime.Enable(session); err != nil {
-		return nil, err
+	return session, nil
+}
+
+// enable turns on the domains the session relies on
+func (s *Session) enable() error {
+	if err := page.Enable(s, page.EnableArgs{}); err != nil {
+		return err
 	}
-	if err = dom.Enable(session, dom.EnableArgs{IncludeWhitespace: "none"}); err != nil {
-		return nil, err
+	if err := page.SetLifecycleEventsEnabled(s, page.SetLifecycleEventsEnabledArgs{Enabled: true}); err != nil {
+		return err
 	}
-	if err = target.SetDiscoverTargets(session, target.SetDiscoverTargetsArgs{Discover: true}); err != nil {
-		return nil, err
+	if err := runtime.Enable(s); err != nil {
+		return err
 	}
-	if err = network.Enable(session, network.EnableArgs{MaxPostDataSize: MaxPostDataSize}); err != nil {
-		return nil, err
+	if err := dom.Enable(s, dom.EnableArgs{IncludeWhitespace: "none"}); err != nil {
+		return err
 	}
-	if err = runtime.AddBinding(session, runtime.AddBindingArgs{Name: hitCheckFunc}); err != nil {
-		return nil, err
+	if err := target.SetDiscoverTargets(s, target.SetDiscoverTargetsArgs{Discover: true}); err != nil {
+		return err
 	}
-	return session, nil
+	if err := network.Enable(s, network.EnableArgs{MaxPostDataSize: MaxPostDataSize}); err != nil {
+		return err
+	}
+	return runtime.AddBinding(s, runtime.AddBindingArgs{Name: hitCheckFunc})
 }
 
 func (s *Session) EnableHighlight() error {
 	if err := overlay.Enable(s); err != nil {
 		return err
 	}
-	s.highlightEnabled = true
+	s.state.highlightEnabled = true
 	return nil
 }
 
@@ -205,6 +273,18 @@ func (s *Session) handle(channel chan cdp.Message) error {
 			frameDetached := mustUnmarshal[page.FrameDetached](message)
 			s.frames.Delete(frameDetached.FrameId)
 
+		case "Network.requestWillBeSent":
+			requestWillBeSent := mustUnmarshal[network.RequestWillBeSent](message)
+			s.inflight.add(requestWillBeSent.RequestId)
+
+		case "Network.loadingFinished":
+			loadingFinished := mustUnmarshal[network.LoadingFinished](message)
+			s.inflight.remove(loadingFinished.RequestId)
+
+		case "Network.loadingFailed":
+			loadingFailed := mustUnmarshal[network.LoadingFailed](message)
+			s.inflight.remove(loadingFailed.RequestId)
+
 		case "Target.detachedFromTarget":
 			detachedFromTarget := mustUnmarshal[target.DetachedFromTarget](message)
 			if s.sessionID == string(detachedFromTarget.SessionId) {
@@ -228,7 +308,7 @@ func (s *Session) handle(channel chan cdp.Message) error {
 }
 
 func (s *Session) funcCalled(fn string) cdp.Future[runtime.BindingCalled] {
-	var channel, cancel = s.Subscribe()
+	var channel, cancel = s.subscribe("Runtime.bindingCalled")
 	callback := func(resolve func(runtime.BindingCalled), reject func(error)) {
 		for value := range channel {
 			if value.Method == "Runtime.bindingCalled" {
@@ -247,6 +327,7 @@ func (s *Session) funcCalled(fn string) cdp.Future[runtime.BindingCalled] {
 	return cdp.NewPromise(callback, cancel)
 }
 
+// CaptureScreenshot is the raw Page.captureScreenshot, see Screenshot for full page, element and masked screenshots
 func (s *Session) CaptureScreenshot(format string, quality int, clip *page.Viewport, fromSurface, captureBeyondViewport, optimizeForSpeed bool) ([]byte, error) {
 	val, err := page.CaptureScreenshot(s, page.CaptureScreenshotArgs{
 		Format:                format,
@@ -301,12 +382,26 @@ func (s *Session) Activate() error {
 	return target.ActivateTarget(s, target.ActivateTargetArgs{TargetId: s.targetID})
 }
 
+// Close closes the page, the page attached by Connection.Attach of the browser made by Connect is detached instead
 func (s *Session) Close() error {
+	if s.state.detachOnClose {
+		return s.Detach()
+	}
 	return s.CloseTarget(s.targetID)
 }
 
+// Detach stops controlling the page leaving it open in the browser
+func (s *Session) Detach() error {
+	err := s.call("", "Target.detachFromTarget", target.DetachFromTargetArgs{SessionId: target.SessionID(s.sessionID)}, nil)
+	/* Target.detachedFromTarget event may come before the response */
+	if err == ErrTargetDetached {
+		return nil
+	}
+	return err
+}
+
 func (s *Session) CloseTarget(id target.TargetID) (err error) {
-	err = target.CloseTarget(s, target.CloseTargetArgs{TargetId: id})
+	_, err = target.CloseTarget(s, target.CloseTargetArgs{TargetId: id})
 	/* Target.detachedFromTarget event may come before the response of CloseTarget call */
 	if err == ErrTargetDetached {
 		return nil
@@ -392,3 +487,25 @@ func (s *Session) NavigateHistory(delta int) error {
 	}
 	return nil
 }
+
+func (s *Session) NavigateHistoryAndWait(delta int, event LifecycleEventType, timeout time.Duration) error {
+	val, err := page.GetNavigationHistory(s)
+	if err != nil {
+		return err
+	}
+	move := val.CurrentIndex + delta
+	if move < 0 || move >= len(val.Entries) {
+		return nil
+	}
+	return s.Frame.waitLifecycle(event, timeout, func() (network.LoaderId, error) {
+		return "", page.NavigateToHistoryEntry(s, page.NavigateToHistoryEntryArgs{
+			EntryId: val.Entries[move].Id,
+		})
+	})
+}
+
+func (s *Session) MustNavigateHistoryAndWait(delta int, event LifecycleEventType, timeout time.Duration) {
+	if err := s.NavigateHistoryAndWait(delta, event, timeout); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,165 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/retrozoid/control/cdptest"
+)
+
+func newTestSession(t *testing.T) (*cdptest.Server, *Session, context.Context) {
+	t.Helper()
+	server := cdptest.NewServer()
+	t.Cleanup(server.Close)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	conn, err := Connect(ctx, server.URL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	session, err := conn.NewPage(Blank)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, session, ctx
+}
+
+func TestSessionHandleTargetEvents(t *testing.T) {
+	for _, test := range []struct {
+		method string
+		params func(s *Session) map[string]any
+		err    error
+	}{
+		{
+			method: "Target.detachedFromTarget",
+			params: func(s *Session) map[string]any { return map[string]any{"sessionId": s.GetID()} },
+			err:    ErrTargetDetached,
+		},
+		{
+			method: "Target.targetDestroyed",
+			params: func(s *Session) map[string]any { return map[string]any{"targetId": s.targetID} },
+			err:    ErrTargetDestroyed,
+		},
+		{
+			method: "Target.targetCrashed",
+			params: func(s *Session) map[string]any {
+				return map[string]any{"targetId": s.targetID, "status": "crashed", "errorCode": 11}
+			},
+			err: TargetCrashedError(nil),
+		},
+	} {
+		t.Run(test.method, func(t *testing.T) {
+			server, session, ctx := newTestSession(t)
+			// events of other targets are ignored, the browser ones have no session id
+			other := map[string]any{"sessionId": "session-other", "targetId": "target-other", "status": "crashed", "errorCode": 11}
+			if err := server.Emit("", test.method, other); err != nil {
+				t.Fatal(err)
+			}
+			if err := server.Emit("", test.method, test.params(session)); err != nil {
+				t.Fatal(err)
+			}
+			select {
+			case <-session.Context().Done():
+			case <-ctx.Done():
+				t.Fatal("session isn't done")
+			}
+			err := context.Cause(session.Context())
+			var crashed TargetCrashedError
+			switch {
+			case errors.As(test.err, &crashed):
+				if !errors.As(err, &crashed) || len(crashed) == 0 {
+					t.Errorf("session is done with %v, TargetCrashedError expected", err)
+				}
+			case !errors.Is(err, test.err):
+				t.Errorf("session is done with %v, %v expected", err, test.err)
+			}
+			if err = session.Call("Page.reload", nil, nil); err == nil {
+				t.Error("done session sends the call")
+			}
+		})
+	}
+}
+
+func TestSessionInterleavedEvents(t *testing.T) {
+	server, session, ctx := newTestSession(t)
+	server.Handle("Runtime.evaluate", func(c cdptest.Call) (any, error) {
+		// the events of the page arrive between the call and its response
+		for _, id := range []string{"1", "2"} {
+			if err := server.Emit(c.SessionID, "Network.requestWillBeSent", map[string]any{"requestId": id}); err != nil {
+				return nil, err
+			}
+		}
+		return map[string]any{"result": map[string]any{"type": "number", "value": 1}}, nil
+	})
+	if err := session.Call("Runtime.evaluate", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	waitInflight(t, ctx, session, 2)
+
+	// unknown request doesn't change the count
+	if err := server.Emit(session.GetID(), "Network.loadingFinished", map[string]any{"requestId": "3"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.Emit(session.GetID(), "Network.loadingFailed", map[string]any{"requestId": "2"}); err != nil {
+		t.Fatal(err)
+	}
+	waitInflight(t, ctx, session, 1)
+	if err := server.Emit(session.GetID(), "Network.loadingFinished", map[string]any{"requestId": "1"}); err != nil {
+		t.Fatal(err)
+	}
+	waitInflight(t, ctx, session, 0)
+	if session.IsDone() {
+		t.Fatalf("session is done with %v", context.Cause(session.Context()))
+	}
+}
+
+// waitInflight waits for the number of inflight requests counted by Session.handle
+func waitInflight(t *testing.T, ctx context.Context, s *Session, want int) {
+	t.Helper()
+	for {
+		count, changed := s.inflight.state()
+		if count == want {
+			return
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			t.Fatalf("%d inflight requests, %d expected", count, want)
+		}
+	}
+}
+
+func TestSessionDisconnectWithCallInFlight(t *testing.T) {
+	server, session, _ := newTestSession(t)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	server.Handle("Page.reload", func(cdptest.Call) (any, error) {
+		close(started)
+		<-release
+		return nil, nil
+	})
+	result := make(chan error, 1)
+	go func() {
+		result <- session.Call("Page.reload", nil, nil)
+	}()
+	<-started
+	server.Disconnect()
+
+	select {
+	case err := <-result:
+		if err == nil || errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("call is not rejected by the disconnect: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("call hangs after the disconnect")
+	}
+	select {
+	case <-session.Context().Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("session isn't done after the disconnect")
+	}
+}
@@ -0,0 +1,202 @@
+package control
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Timeouts bound every CDP round-trip of the session by the category of its method
+type Timeouts struct {
+	// Default is used by methods out of other categories and by categories left zero
+	Default time.Duration
+	// Navigation bounds Page.navigate, Page.reload, history navigation and lifecycle waits without explicit timeout
+	Navigation time.Duration
+	// Action bounds Input domain and the confirmation of clicks
+	Action time.Duration
+	// Evaluation bounds Runtime.evaluate, Runtime.callFunctionOn and Runtime.awaitPromise
+	Evaluation time.Duration
+}
+
+var DefaultTimeouts = Timeouts{
+	Default: 60 * time.Second,
+}
+
+func (t Timeouts) orDefault(value time.Duration) time.Duration {
+	if value > 0 {
+		return value
+	}
+	if t.Default > 0 {
+		return t.Default
+	}
+	return DefaultTimeouts.Default
+}
+
+func (t Timeouts) navigation() time.Duration {
+	return t.orDefault(t.Navigation)
+}
+
+func (t Timeouts) action() time.Duration {
+	return t.orDefault(t.Action)
+}
+
+func (t Timeouts) evaluation() time.Duration {
+	return t.orDefault(t.Evaluation)
+}
+
+func (t Timeouts) method(method string) time.Duration {
+	switch method {
+	case "Page.navigate", "Page.reload", "Page.navigateToHistoryEntry":
+		return t.navigation()
+	case "Runtime.evaluate", "Runtime.callFunctionOn", "Runtime.awaitPromise":
+		return t.evaluation()
+	}
+	if strings.HasPrefix(method, "Input.") {
+		return t.action()
+	}
+	return t.orDefault(0)
+}
+
+// timeoutsState is read by every call while the timeouts may be replaced
+type timeoutsState struct {
+	mutex sync.RWMutex
+	value Timeouts
+}
+
+func newTimeoutsState(timeouts Timeouts) *timeoutsState {
+	return &timeoutsState{value: timeouts}
+}
+
+// Timeouts returns the timeouts of the session
+func (s *Session) Timeouts() Timeouts {
+	s.timeouts.mutex.RLock()
+	defer s.timeouts.mutex.RUnlock()
+	return s.timeouts.value
+}
+
+// SetTimeouts replaces the timeouts of the session, the view made by WithContext has its own ones
+func (s *Session) SetTimeouts(timeouts Timeouts) {
+	s.timeouts.mutex.Lock()
+	defer s.timeouts.mutex.Unlock()
+	s.timeouts.value = timeouts
+}
+
+// SetTimeout replaces the default timeout of the session
+func (s *Session) SetTimeout(timeout time.Duration) {
+	s.timeouts.mutex.Lock()
+	defer s.timeouts.mutex.Unlock()
+	s.timeouts.value.Default = timeout
+}
+
+// WithContext returns the view of the session sharing its state,
+// every call and wait made through the view is cancelled with ctx as well
+func (s *Session) WithContext(ctx context.Context) *Session {
+	view := *s
+	view.caller = ctx
+	view.timeouts = newTimeoutsState(s.Timeouts())
+	view.mouse.caller = &view
+	view.kb.caller = &view
+	view.touch.caller = &view
+	frame := *s.Frame
+	frame.session = &view
+	view.Frame = &frame
+	return &view
+}
+
+// WithContext returns the frame bound to ctx, see Session.WithContext
+func (f Frame) WithContext(ctx context.Context) Frame {
+	f.session = f.session.WithContext(ctx)
+	return f
+}
+
+// WithContext returns the node bound to ctx, see Session.WithContext
+func (e Node) WithContext(ctx context.Context) Node {
+	frame := e.frame.WithContext(ctx)
+	e.frame = &frame
+	return e
+}
+
+// withTimeout returns the context done by the session end, the caller context of the view or the timeout if it's set
+func (s *Session) withTimeout(timeout time.Duration) (context.Context, context.CancelFunc) {
+	return withCaller(s.context, s.caller, timeout)
+}
+
+// callerDone returns the error of the caller context of the view if it's done
+func (s *Session) callerDone() error {
+	return callerDone(s.caller)
+}
+
+// withCaller returns the context done by the parent, the caller context if it's set or the timeout if it's set
+func withCaller(parent, caller context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(parent, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(parent)
+	}
+	if caller == nil {
+		return ctx, cancel
+	}
+	ctx, cancelCause := context.WithCancelCause(ctx)
+	stop := context.AfterFunc(caller, func() {
+		cancelCause(context.Cause(caller))
+	})
+	return ctx, func() {
+		stop()
+		cancelCause(context.Canceled)
+		cancel()
+	}
+}
+
+func callerDone(caller context.Context) error {
+	if caller == nil {
+		return nil
+	}
+	select {
+	case <-caller.Done():
+		return context.Cause(caller)
+	default:
+		return nil
+	}
+}
+
+// Timeouts returns the timeouts of browser level calls, they are inherited by the sessions of the connection
+func (c *Connection) Timeouts() Timeouts {
+	c.timeouts.mutex.RLock()
+	defer c.timeouts.mutex.RUnlock()
+	return c.timeouts.value
+}
+
+// SetTimeouts replaces the timeouts of the connection, the view made by WithContext has its own ones
+func (c *Connection) SetTimeouts(timeouts Timeouts) {
+	c.timeouts.mutex.Lock()
+	defer c.timeouts.mutex.Unlock()
+	c.timeouts.value = timeouts
+}
+
+// SetTimeout replaces the default timeout of the connection
+func (c *Connection) SetTimeout(timeout time.Duration) {
+	c.timeouts.mutex.Lock()
+	defer c.timeouts.mutex.Unlock()
+	c.timeouts.value.Default = timeout
+}
+
+// WithContext returns the view of the connection, every call made through the view is cancelled with ctx as well.
+// Sessions opened through the view are bound to ctx until they are attached
+func (c *Connection) WithContext(ctx context.Context) *Connection {
+	view := *c
+	view.caller = ctx
+	view.timeouts = newTimeoutsState(c.Timeouts())
+	return &view
+}
+
+// WithContext returns the browser context calling through the view bound to ctx, see Connection.WithContext
+func (c *BrowserContext) WithContext(ctx context.Context) *BrowserContext {
+	view := *c
+	view.caller = c.caller.withContext(ctx)
+	return &view
+}
@@ -0,0 +1,226 @@
+// Package visual compares screenshots with golden images pixel by pixel
+package visual
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+)
+
+// maxDelta is the squared YIQ distance between black and white
+const maxDelta = 35215
+
+var (
+	DefaultThreshold = 0.1
+	DiffColor        = color.RGBA{R: 255, A: 255}
+	AntiAliasedColor = color.RGBA{R: 255, G: 255, A: 255}
+)
+
+type Options struct {
+	// Threshold of color distance in range 0..1 under which pixels are equal, DefaultThreshold if it's zero
+	Threshold float64
+	// MaxDiffRatio is the share of different pixels allowed, 0 requires the images to match
+	MaxDiffRatio float64
+	// IncludeAntiAliased counts anti-aliased pixels as different, they are ignored by default
+	IncludeAntiAliased bool
+}
+
+func (o Options) threshold() float64 {
+	if o.Threshold > 0 {
+		return o.Threshold
+	}
+	return DefaultThreshold
+}
+
+// SizeMismatchError is returned when the images have different bounds
+type SizeMismatchError struct {
+	Expected image.Rectangle
+	Actual   image.Rectangle
+}
+
+func (e SizeMismatchError) Error() string {
+	return fmt.Sprintf("image size %dx%d doesn't match expected %dx%d", e.Actual.Dx(), e.Actual.Dy(), e.Expected.Dx(), e.Expected.Dy())
+}
+
+type Result struct {
+	DiffPixels        int
+	AntiAliasedPixels int
+	TotalPixels       int
+	// Diff is the faded expected image with different pixels painted by DiffColor and anti-aliased ones by AntiAliasedColor
+	Diff *image.RGBA
+	// Passed is true when DiffPixels fit into MaxDiffRatio
+	Passed bool
+}
+
+func (r Result) Ratio() float64 {
+	if r.TotalPixels == 0 {
+		return 0
+	}
+	return float64(r.DiffPixels) / float64(r.TotalPixels)
+}
+
+// Compare compares the images by YIQ color distance detecting anti-aliasing as pixelmatch does
+func Compare(expected, actual image.Image, opts Options) (Result, error) {
+	if expected.Bounds().Size() != actual.Bounds().Size() {
+		return Result{}, SizeMismatchError{Expected: expected.Bounds(), Actual: actual.Bounds()}
+	}
+	var (
+		img1   = toRGBA(expected)
+		img2   = toRGBA(actual)
+		width  = img1.Rect.Dx()
+		height = img1.Rect.Dy()
+		limit  = maxDelta * opts.threshold() * opts.threshold()
+		result = Result{TotalPixels: width * height, Diff: image.NewRGBA(image.Rect(0, 0, width, height))}
+	)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			pos := y*img1.Stride + x*4
+			delta := colorDelta(img1.Pix, img2.Pix, pos, pos, false)
+			switch {
+			case math.Abs(delta) <= limit:
+				result.Diff.SetRGBA(x, y, fade(img1.Pix, pos))
+			case !opts.IncludeAntiAliased && (antiAliased(img1, x, y, img2) || antiAliased(img2, x, y, img1)):
+				result.AntiAliasedPixels++
+				result.Diff.SetRGBA(x, y, AntiAliasedColor)
+			default:
+				result.DiffPixels++
+				result.Diff.SetRGBA(x, y, DiffColor)
+			}
+		}
+	}
+	result.Passed = result.Ratio() <= opts.MaxDiffRatio
+	return result, nil
+}
+
+// toRGBA copies the image to RGBA starting at the origin
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Rect.Min == (image.Point{}) {
+		return rgba
+	}
+	rgba := image.NewRGBA(image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy()))
+	draw.Draw(rgba, rgba.Rect, img, img.Bounds().Min, draw.Src)
+	return rgba
+}
+
+// blend mixes the color channel with white by alpha
+func blend(c, a float64) float64 {
+	return 255 + (c-255)*a
+}
+
+func rgb2y(r, g, b float64) float64 { return r*0.29889531 + g*0.58662247 + b*0.11448223 }
+func rgb2i(r, g, b float64) float64 { return r*0.59597799 - g*0.27417610 - b*0.32180189 }
+func rgb2q(r, g, b float64) float64 { return r*0.21147017 - g*0.52261711 + b*0.31114694 }
+
+func pixel(pix []uint8, pos int) (r, g, b float64) {
+	r, g, b = float64(pix[pos]), float64(pix[pos+1]), float64(pix[pos+2])
+	// RGBA is premultiplied, blend it with white background
+	if a := float64(pix[pos+3]); a < 255 {
+		r, g, b = r+255-a, g+255-a, b+255-a
+	}
+	return
+}
+
+// colorDelta is the squared YIQ distance, negative if the first pixel is lighter
+func colorDelta(pix1, pix2 []uint8, k, m int, yOnly bool) float64 {
+	r1, g1, b1 := pixel(pix1, k)
+	r2, g2, b2 := pixel(pix2, m)
+	y1, y2 := rgb2y(r1, g1, b1), rgb2y(r2, g2, b2)
+	y := y1 - y2
+	if yOnly {
+		return y
+	}
+	i := rgb2i(r1, g1, b1) - rgb2i(r2, g2, b2)
+	q := rgb2q(r1, g1, b1) - rgb2q(r2, g2, b2)
+	delta := 0.5053*y*y + 0.299*i*i + 0.1957*q*q
+	if y1 > y2 {
+		return -delta
+	}
+	return delta
+}
+
+// fade renders the unchanged pixel as light grey
+func fade(pix []uint8, pos int) color.RGBA {
+	r, g, b := pixel(pix, pos)
+	v := uint8(blend(rgb2y(r, g, b), 0.1))
+	return color.RGBA{R: v, G: v, B: v, A: 255}
+}
+
+// neighbours calls fn for the pixels around (x, y) until it returns false
+func neighbours(img *image.RGBA, x, y int, fn func(nx, ny int) bool) {
+	x0, y0 := max(x-1, 0), max(y-1, 0)
+	x1, y1 := min(x+1, img.Rect.Dx()-1), min(y+1, img.Rect.Dy()-1)
+	for nx := x0; nx <= x1; nx++ {
+		for ny := y0; ny <= y1; ny++ {
+			if (nx != x || ny != y) && !fn(nx, ny) {
+				return
+			}
+		}
+	}
+}
+
+// antiAliased checks if the pixel is likely a part of anti-aliasing,
+// see "Anti-aliased Pixel and Intensity Slope Detector" by V. Vyšniauskas, 2009
+func antiAliased(img *image.RGBA, x, y int, other *image.RGBA) bool {
+	var (
+		pos                    = y*img.Stride + x*4
+		zeroes                 int
+		darkest, lightest      float64
+		minX, minY, maxX, maxY int
+		tooManyEqual           bool
+	)
+	if onEdge(img, x, y) {
+		zeroes++
+	}
+	neighbours(img, x, y, func(nx, ny int) bool {
+		delta := colorDelta(img.Pix, img.Pix, pos, ny*img.Stride+nx*4, true)
+		switch {
+		case delta == 0:
+			zeroes++
+			if zeroes > 2 {
+				tooManyEqual = true
+				return false
+			}
+		case delta < darkest:
+			darkest, minX, minY = delta, nx, ny
+		case delta > lightest:
+			lightest, maxX, maxY = delta, nx, ny
+		}
+		return true
+	})
+	if tooManyEqual || darkest == 0 || lightest == 0 {
+		return false
+	}
+	return (hasManySiblings(img, minX, minY) && hasManySiblings(other, minX, minY)) ||
+		(hasManySiblings(img, maxX, maxY) && hasManySiblings(other, maxX, maxY))
+}
+
+// hasManySiblings checks if the pixel has 3 or more equal neighbours
+func hasManySiblings(img *image.RGBA, x, y int) bool {
+	var (
+		pos    = y*img.Stride + x*4
+		zeroes int
+		many   bool
+	)
+	if onEdge(img, x, y) {
+		zeroes++
+	}
+	neighbours(img, x, y, func(nx, ny int) bool {
+		npos := ny*img.Stride + nx*4
+		if img.Pix[pos] == img.Pix[npos] && img.Pix[pos+1] == img.Pix[npos+1] &&
+			img.Pix[pos+2] == img.Pix[npos+2] && img.Pix[pos+3] == img.Pix[npos+3] {
+			zeroes++
+		}
+		if zeroes > 2 {
+			many = true
+			return false
+		}
+		return true
+	})
+	return many
+}
+
+func onEdge(img *image.RGBA, x, y int) bool {
+	return x == 0 || y == 0 || x == img.Rect.Dx()-1 || y == img.Rect.Dy()-1
+}
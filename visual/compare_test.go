@@ -0,0 +1,122 @@
+package visual
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+)
+
+// fill returns the image painted by the function of pixel coordinates
+func fill(width, height int, paint func(x, y int) color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetRGBA(x, y, paint(x, y))
+		}
+	}
+	return img
+}
+
+func gray(v uint8) color.RGBA {
+	return color.RGBA{R: v, G: v, B: v, A: 255}
+}
+
+func solid(c color.RGBA) func(x, y int) color.RGBA {
+	return func(int, int) color.RGBA { return c }
+}
+
+// dot paints the pixel (3, 3) of the white image
+func dot(c color.RGBA) func(x, y int) color.RGBA {
+	return func(x, y int) color.RGBA {
+		if x == 3 && y == 3 {
+			return c
+		}
+		return gray(255)
+	}
+}
+
+// edge paints the left half black and the right half white, the column at the border is of the color
+func edge(c color.RGBA) func(x, y int) color.RGBA {
+	return func(x, y int) color.RGBA {
+		switch {
+		case x < 4:
+			return gray(0)
+		case x == 4:
+			return c
+		default:
+			return gray(255)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		expected    *image.RGBA
+		actual      *image.RGBA
+		opts        Options
+		diff        int
+		antiAliased int
+		passed      bool
+	}{
+		{"identical", fill(8, 8, dot(gray(0))), fill(8, 8, dot(gray(0))), Options{}, 0, 0, true},
+		{"single pixel", fill(8, 8, solid(gray(255))), fill(8, 8, dot(gray(0))), Options{}, 1, 0, false},
+		{"single pixel allowed", fill(8, 8, solid(gray(255))), fill(8, 8, dot(gray(0))), Options{MaxDiffRatio: 1.0 / 64}, 1, 0, true},
+		{"single pixel over ratio", fill(8, 8, solid(gray(255))), fill(8, 8, dot(gray(0))), Options{MaxDiffRatio: 0.99 / 64}, 1, 0, false},
+		// squared YIQ distance of grey levels is 0.5053*d*d, the default threshold limit is 352.15
+		{"under threshold", fill(8, 8, solid(gray(200))), fill(8, 8, solid(gray(226))), Options{}, 0, 0, true},
+		{"over threshold", fill(8, 8, solid(gray(200))), fill(8, 8, solid(gray(227))), Options{}, 64, 0, false},
+		{"over threshold with higher one", fill(8, 8, solid(gray(200))), fill(8, 8, solid(gray(227))), Options{Threshold: 0.2}, 0, 0, true},
+		{"anti-aliased edge", fill(8, 8, edge(gray(255))), fill(8, 8, edge(gray(128))), Options{}, 0, 8, true},
+		{"anti-aliased edge included", fill(8, 8, edge(gray(255))), fill(8, 8, edge(gray(128))), Options{IncludeAntiAliased: true}, 8, 0, false},
+		// shifted hard edge has no intermediate color to be anti-aliasing
+		{"moved edge", fill(8, 8, edge(gray(255))), fill(8, 8, edge(gray(0))), Options{}, 8, 0, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := Compare(test.expected, test.actual, test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.DiffPixels != test.diff || result.AntiAliasedPixels != test.antiAliased || result.Passed != test.passed {
+				t.Errorf("diff %d, anti-aliased %d, passed %v, want %d, %d, %v",
+					result.DiffPixels, result.AntiAliasedPixels, result.Passed, test.diff, test.antiAliased, test.passed)
+			}
+			if result.TotalPixels != 64 || result.Diff.Rect != test.expected.Rect {
+				t.Errorf("unexpected total %d and diff bounds %v", result.TotalPixels, result.Diff.Rect)
+			}
+		})
+	}
+}
+
+func TestCompareDiffImage(t *testing.T) {
+	result, err := Compare(fill(8, 8, solid(gray(255))), fill(8, 8, dot(gray(0))), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := result.Diff.RGBAAt(3, 3); c != DiffColor {
+		t.Errorf("different pixel is painted %v", c)
+	}
+	if c := result.Diff.RGBAAt(0, 0); c.R != c.G || c.G != c.B || c.R < 230 {
+		t.Errorf("equal pixel isn't faded: %v", c)
+	}
+}
+
+func TestCompareBounds(t *testing.T) {
+	_, err := Compare(fill(8, 8, solid(gray(255))), fill(8, 7, solid(gray(255))), Options{})
+	var mismatch SizeMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("size mismatch isn't reported: %v", err)
+	}
+
+	// sub-image is compared from its own origin
+	expected := fill(4, 4, dot(gray(0)))
+	actual := fill(8, 8, func(x, y int) color.RGBA { return dot(gray(0))(x-4, y-4) }).SubImage(image.Rect(4, 4, 8, 8))
+	result, err := Compare(expected, actual, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.DiffPixels != 0 {
+		t.Errorf("%d pixels of the sub-image differ", result.DiffPixels)
+	}
+}
@@ -0,0 +1,133 @@
+package visual
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"image"
+	"image/png"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/retrozoid/control"
+)
+
+// UpdateEnv enables update mode when the variable is set to a non-empty value, as -update-goldens flag does
+var UpdateEnv = "UPDATE_GOLDENS"
+
+var updateFlag = flag.Bool("update-goldens", false, "write actual screenshots to golden files instead of comparing")
+
+// Updating reports whether golden files are rewritten instead of compared
+func Updating() bool {
+	return *updateFlag || os.Getenv(UpdateEnv) != ""
+}
+
+var ErrGoldenNotFound = errors.New("golden image not found, run with -update-goldens to create it")
+
+// MismatchError is returned when the screenshot differs from the golden image more than allowed
+type MismatchError struct {
+	Golden   string
+	DiffPath string
+	Result   Result
+}
+
+func (e MismatchError) Error() string {
+	return fmt.Sprintf("%s: %d of %d pixels differ (%.4f%%), see %s", e.Golden, e.Result.DiffPixels, e.Result.TotalPixels, e.Result.Ratio()*100, e.DiffPath)
+}
+
+// DiffPath is the path of the diff image written next to the golden one, e.g. button.diff.png for button.png
+func DiffPath(golden string) string {
+	return strings.TrimSuffix(golden, filepath.Ext(golden)) + ".diff.png"
+}
+
+// ActualPath is the path of the actual image written next to the golden one on mismatch
+func ActualPath(golden string) string {
+	return strings.TrimSuffix(golden, filepath.Ext(golden)) + ".actual.png"
+}
+
+// CompareGolden compares the image with the golden PNG file. The diff and the actual images are written next to it on mismatch,
+// in update mode the golden file is rewritten and the comparison is skipped
+func CompareGolden(golden string, actual image.Image, opts Options) (Result, error) {
+	if Updating() {
+		return Result{Passed: true}, writePNG(golden, actual)
+	}
+	expected, err := readPNG(golden)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Result{}, errors.Join(fmt.Errorf("%s: %w", golden, ErrGoldenNotFound), writePNG(ActualPath(golden), actual))
+	}
+	if err != nil {
+		return Result{}, err
+	}
+	result, err := Compare(expected, actual, opts)
+	if err != nil {
+		return result, errors.Join(fmt.Errorf("%s: %w", golden, err), writePNG(ActualPath(golden), actual))
+	}
+	if result.Passed {
+		// diff of the previous run is stale
+		for _, path := range []string{DiffPath(golden), ActualPath(golden)} {
+			if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return result, err
+			}
+		}
+		return result, nil
+	}
+	if err = errors.Join(writePNG(DiffPath(golden), result.Diff), writePNG(ActualPath(golden), actual)); err != nil {
+		return result, err
+	}
+	return result, MismatchError{Golden: golden, DiffPath: DiffPath(golden), Result: result}
+}
+
+func readPNG(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return png.Decode(file)
+}
+
+func writePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err = png.Encode(file, img); err != nil {
+		return errors.Join(err, file.Close())
+	}
+	return file.Close()
+}
+
+// Screenshotter is implemented by control.Session and control.Node
+type Screenshotter interface {
+	ScreenshotImage(control.ScreenshotOptions) (image.Image, error)
+}
+
+// Match captures the screenshot of the session or the element and compares it with the golden file,
+// the failure is reported to t
+func Match(t testing.TB, target Screenshotter, golden string, screenshot control.ScreenshotOptions, opts Options) Result {
+	t.Helper()
+	actual, err := target.ScreenshotImage(screenshot)
+	if err != nil {
+		t.Fatalf("can't take screenshot for %s: %s", golden, err)
+		return Result{}
+	}
+	return MatchImage(t, actual, golden, opts)
+}
+
+// MatchImage compares the image with the golden file, the failure is reported to t
+func MatchImage(t testing.TB, actual image.Image, golden string, opts Options) Result {
+	t.Helper()
+	result, err := CompareGolden(golden, actual, opts)
+	if err != nil {
+		t.Errorf("%s", err)
+	} else if Updating() {
+		t.Logf("golden image %s updated", golden)
+	}
+	return result
+}